@@ -0,0 +1,20 @@
+# Scale a Standard cluster out before a batch job. The cluster resource plans
+# the size back to min_nodes unless it ignores changes to it.
+action "scylladbcloud_cluster_resize" "scale_out" {
+	config {
+		cluster_id = scylladbcloud_cluster.example.cluster_id
+		node_count = 6
+		timeout    = "90m"
+	}
+}
+
+# Raise the minimums of an X Cloud cluster.
+action "scylladbcloud_cluster_resize" "xcloud" {
+	config {
+		cluster_id     = scylladbcloud_cluster.xcloud.cluster_id
+		vcpu_min       = 16
+		storage_min_gb = 500
+	}
+}
+
+# terraform apply -invoke=action.scylladbcloud_cluster_resize.scale_out
@@ -0,0 +1,22 @@
+action "scylladbcloud_cluster_wait_idle" "example" {
+	config {
+		cluster_id = scylladbcloud_cluster.example.cluster_id
+		timeout    = "30m"
+	}
+}
+
+# Run migrations only once the cluster has no requests in progress.
+resource "terraform_data" "migrations" {
+	input = scylladbcloud_cluster.example.min_nodes
+
+	lifecycle {
+		action_trigger {
+			events  = [before_create, before_update]
+			actions = [action.scylladbcloud_cluster_wait_idle.example]
+		}
+	}
+
+	provisioner "local-exec" {
+		command = "./migrate.sh"
+	}
+}
@@ -0,0 +1,16 @@
+# Pin a cluster to two availability zones by their zone IDs, which are the
+# same across AWS accounts unlike the zone names.
+data "scylladbcloud_availability_zones" "us_east_1" {
+	cloud  = "AWS"
+	region = "us-east-1"
+}
+
+resource "scylladbcloud_cluster" "example" {
+	name                  = "My Cluster"
+	cloud                 = "AWS"
+	region                = "us-east-1"
+	min_nodes             = 3
+	node_type             = "i4i.large"
+	cidr_block            = "172.31.0.0/16"
+	availability_zone_ids = slice(data.scylladbcloud_availability_zones.us_east_1.zone_ids, 0, 2)
+}
@@ -0,0 +1,16 @@
+# Deploy a cluster to your own AWS account (BYOA) without hardcoding its ID.
+data "scylladbcloud_cloud_accounts" "byoa" {
+	cloud = "AWS"
+	owner = "Customer"
+	state = "ACTIVE"
+}
+
+resource "scylladbcloud_cluster" "example" {
+	name       = "My Cluster"
+	cloud      = "AWS"
+	region     = "us-east-1"
+	min_nodes  = 3
+	node_type  = "i4i.large"
+	cidr_block = "172.31.0.0/16"
+	byoa_id    = one(data.scylladbcloud_cloud_accounts.byoa.ids)
+}
@@ -0,0 +1,16 @@
+# Look up a cluster owned by another team by its name.
+data "scylladbcloud_cluster" "example" {
+	name = "my-cluster"
+}
+
+output "scylladbcloud_cluster_id" {
+	value = data.scylladbcloud_cluster.example.cluster_id
+}
+
+output "scylladbcloud_cluster_cidr_block" {
+	value = data.scylladbcloud_cluster.example.cidr_block
+}
+
+output "scylladbcloud_cluster_ca_certificate" {
+	value = data.scylladbcloud_cluster.example.ca_certificate
+}
@@ -0,0 +1,10 @@
+# Show why the last resize of a cluster failed.
+data "scylladbcloud_cluster_requests" "failed_resizes" {
+	cluster_id = scylladbcloud_cluster.example.cluster_id
+	type       = "RESIZE_CLUSTER_V2"
+	status     = "FAILED"
+}
+
+output "last_failed_resize" {
+	value = try(reverse(data.scylladbcloud_cluster_requests.failed_resizes.requests)[0], null)
+}
@@ -0,0 +1,13 @@
+# Allowlist the office IP on every active production cluster in AWS.
+data "scylladbcloud_clusters" "prod" {
+	name_regex = "^prod-"
+	cloud      = "AWS"
+	status     = "ACTIVE"
+}
+
+resource "scylladbcloud_allowlist_rule" "office" {
+	for_each = { for c in data.scylladbcloud_clusters.prod.clusters : c.name => c.cluster_id }
+
+	cluster_id = each.value
+	cidr_block = "203.0.113.10/32"
+}
@@ -0,0 +1,16 @@
+# Store a connection bundle of a standard cluster for the applications,
+# which reach the cluster over VPC peering.
+data "scylladbcloud_connection_bundle" "example" {
+	cluster_id   = 1337
+	address_type = "PRIVATE_IP"
+}
+
+resource "kubernetes_secret" "scylla" {
+	metadata {
+		name = "scylla-connection-bundle"
+	}
+
+	data = {
+		"connect-bundle.yaml" = data.scylladbcloud_connection_bundle.example.connection_bundle
+	}
+}
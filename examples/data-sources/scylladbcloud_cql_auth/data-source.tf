@@ -20,3 +20,11 @@ output "scylladbcloud_cql_password" {
 output "scylladbcloud_cql_cluster_name" {
   value = data.scylladbcloud_cql_auth.example.cluster_name
 }
+
+# Contact points of every datacenter, for multi-datacenter aware drivers.
+output "scylladbcloud_cql_contact_points" {
+	value = {
+		for dc in data.scylladbcloud_cql_auth.example.datacenters :
+		dc.name => formatlist("%s:%d", dc.dns, data.scylladbcloud_cql_auth.example.port)
+	}
+}
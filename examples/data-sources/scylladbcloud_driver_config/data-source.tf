@@ -0,0 +1,21 @@
+# Write ready-to-use driver configurations for the cluster.
+data "scylladbcloud_driver_config" "example" {
+	cluster_id = 1337
+	ca_file    = "${path.module}/ca.crt"
+}
+
+resource "local_file" "ca" {
+	count    = data.scylladbcloud_driver_config.example.tls_enabled ? 1 : 0
+	filename = data.scylladbcloud_driver_config.example.ca_file
+	content  = data.scylladbcloud_driver_config.example.ca_certificate
+}
+
+resource "local_sensitive_file" "cqlshrc" {
+	filename = "${path.module}/cqlshrc"
+	content  = data.scylladbcloud_driver_config.example.cqlshrc
+}
+
+resource "local_sensitive_file" "application_conf" {
+	filename = "${path.module}/application.conf"
+	content  = data.scylladbcloud_driver_config.example.java_application_conf
+}
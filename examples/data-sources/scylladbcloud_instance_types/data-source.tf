@@ -0,0 +1,11 @@
+# Find the i8g instance types with at least 8 vCPUs in us-east-1.
+data "scylladbcloud_instance_types" "example" {
+	cloud         = "AWS"
+	region        = "us-east-1"
+	family        = "i8g"
+	min_cpu_count = 8
+}
+
+output "scylladbcloud_instance_types" {
+	value = data.scylladbcloud_instance_types.example.names
+}
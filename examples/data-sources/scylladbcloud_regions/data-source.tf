@@ -0,0 +1,13 @@
+# List the AWS regions clusters can be deployed in.
+data "scylladbcloud_regions" "aws" {
+	cloud = "AWS"
+}
+
+variable "region" {
+	type = string
+
+	validation {
+		condition     = contains(data.scylladbcloud_regions.aws.names, var.region)
+		error_message = "The region is not supported by ScyllaDB Cloud."
+	}
+}
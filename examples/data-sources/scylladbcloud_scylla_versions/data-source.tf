@@ -0,0 +1,6 @@
+# Read the Scylla version new clusters get by default.
+data "scylladbcloud_scylla_versions" "all" {}
+
+output "scylladbcloud_default_scylla_version" {
+	value = data.scylladbcloud_scylla_versions.all.default_version
+}
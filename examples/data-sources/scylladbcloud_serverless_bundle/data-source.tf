@@ -7,3 +7,7 @@ output "scylladbcloud_serverless_bundle" {
     sensitive = true
 	value     = data.scylladbcloud_serverless_bundle.example.connection_bundle
 }
+
+output "scylladbcloud_serverless_server" {
+	value = "${data.scylladbcloud_serverless_bundle.my.server}:${data.scylladbcloud_serverless_bundle.my.port}"
+}
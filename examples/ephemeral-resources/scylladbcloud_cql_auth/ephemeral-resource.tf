@@ -0,0 +1,19 @@
+# Store the CQL credentials in AWS Secrets Manager without writing them to the
+# Terraform state.
+ephemeral "scylladbcloud_cql_auth" "example" {
+	cluster_id = 1337
+}
+
+resource "aws_secretsmanager_secret" "cql" {
+	name = "scylla-cql-credentials"
+}
+
+resource "aws_secretsmanager_secret_version" "cql" {
+	secret_id = aws_secretsmanager_secret.cql.id
+	secret_string_wo = jsonencode({
+		username = ephemeral.scylladbcloud_cql_auth.example.username
+		password = ephemeral.scylladbcloud_cql_auth.example.password
+		seeds    = ephemeral.scylladbcloud_cql_auth.example.seed_list
+	})
+	secret_string_wo_version = 1
+}
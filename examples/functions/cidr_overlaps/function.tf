@@ -0,0 +1,19 @@
+variable "peer_cidr_block" {
+  type = string
+}
+
+resource "scylladbcloud_cluster" "example" {
+  name       = "My Cluster"
+  cloud      = "AWS"
+  region     = "us-east-1"
+  min_nodes  = 3
+  node_type  = "i3.large"
+  cidr_block = "172.31.0.0/16"
+
+  lifecycle {
+    precondition {
+      condition     = !provider::scylladbcloud::cidr_overlaps("172.31.0.0/16", var.peer_cidr_block)
+      error_message = "The cluster CIDR block must not overlap the peer network."
+    }
+  }
+}
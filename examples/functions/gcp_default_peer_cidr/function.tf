@@ -0,0 +1,5 @@
+# The CIDR block a GCP VPC peering in us-east1 uses by default, e.g. to allow
+# it in the firewall rules of the peer network.
+output "scylladbcloud_gcp_peer_cidr" {
+  value = provider::scylladbcloud::gcp_default_peer_cidr("us-east1")
+}
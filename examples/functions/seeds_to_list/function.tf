@@ -0,0 +1,7 @@
+data "scylladbcloud_cql_auth" "example" {
+  cluster_id = 1337
+}
+
+output "first_seed" {
+  value = provider::scylladbcloud::seeds_to_list(data.scylladbcloud_cql_auth.example.seeds)[0]
+}
@@ -0,0 +1,8 @@
+variable "nodes" {
+  type = number
+
+  validation {
+    condition     = provider::scylladbcloud::valid_min_nodes(var.nodes)
+    error_message = "The number of nodes must be at least 3 and divisible by 3."
+  }
+}
@@ -0,0 +1,4 @@
+# Discover the allowlist rules of every cluster of the account.
+list "scylladbcloud_allowlist_rule" "all" {
+  provider = scylladbcloud
+}
@@ -0,0 +1,11 @@
+# Discover the active clusters whose name starts with "prod". Run with
+# "terraform query -generate-config-out=clusters.tf" to generate their import
+# blocks and configuration.
+list "scylladbcloud_cluster" "prod" {
+  provider = scylladbcloud
+
+  config {
+    name_regex = "^prod"
+    status     = "ACTIVE"
+  }
+}
@@ -0,0 +1,8 @@
+# Discover the connections of a single cluster.
+list "scylladbcloud_cluster_connection" "example" {
+  provider = scylladbcloud
+
+  config {
+    cluster_id = 1337
+  }
+}
@@ -0,0 +1,8 @@
+# Discover the VPC peerings of a single cluster.
+list "scylladbcloud_vpc_peering" "example" {
+  provider = scylladbcloud
+
+  config {
+    cluster_id = 1337
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = scylladbcloud_allowlist_rule.example
+  identity = {
+    cluster_id = 1337
+    rule_id    = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = scylladbcloud_cluster.example
+  identity = {
+    cluster_id = 1337
+  }
+}
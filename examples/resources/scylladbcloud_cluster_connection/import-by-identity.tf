@@ -0,0 +1,7 @@
+import {
+  to = scylladbcloud_cluster_connection.example
+  identity = {
+    cluster_id    = 1337
+    connection_id = 42
+  }
+}
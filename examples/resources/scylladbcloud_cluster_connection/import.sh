@@ -1,2 +1,8 @@
-# An cluster connection can be imported by specifying the numeric identifier.
+# A cluster connection can be imported by specifying the cluster and the
+# connection, each either by numeric identifier or by name.
+terraform import scylladbcloud_cluster_connection.example 1337/123
+terraform import scylladbcloud_cluster_connection.example my-cluster/aws-tgw-test
+
+# The numeric connection identifier alone is also accepted; the connection is
+# then looked up across all clusters of the account.
 terraform import scylladbcloud_cluster_connection.example 123
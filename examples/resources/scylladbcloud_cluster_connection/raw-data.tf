@@ -0,0 +1,14 @@
+# Connection types without a typed block can be configured with the raw
+# "type" and "data" arguments.
+
+resource "scylladbcloud_cluster_connection" "raw" {
+	cluster_id = 1337
+	name       = "aws-tgw-test"
+	cidrlist = ["10.201.0.0/16"]
+	type = "AWS_TGW_ATTACHMENT"
+	datacenter = "AWS_US_EAST_1"
+	data = {
+		tgwid  = "tgw-08461afa1119f390a"
+		ramarn = "arn:aws:ram:us-east-1:043400831220:resource-share/be3b0395-1782-47cb-9ae4-6d3517c6a721"
+	}
+}
@@ -0,0 +1,24 @@
+# Wait for the cluster to settle after each resize before running migrations.
+resource "scylladbcloud_cluster_ready" "example" {
+	cluster_id = scylladbcloud_cluster.example.cluster_id
+	check_cql  = true
+
+	triggers = {
+		node_type = scylladbcloud_cluster.example.node_type
+		min_nodes = scylladbcloud_cluster.example.min_nodes
+	}
+
+	timeouts {
+		create = "90m"
+	}
+}
+
+resource "null_resource" "migrations" {
+	triggers = {
+		ready_at = scylladbcloud_cluster_ready.example.ready_at
+	}
+
+	provisioner "local-exec" {
+		command = "./migrate.sh"
+	}
+}
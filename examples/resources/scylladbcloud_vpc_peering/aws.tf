@@ -9,12 +9,15 @@ resource "scylladbcloud_vpc_peering" "example" {
 	cluster_id = 1337
 	datacenter = "AWS_EAST_1"
 
-	peer_vpc_id     = aws_vpc.app.id
-	peer_cidr_block = aws_vpc.app.cidr_block
-	peer_region     = "us-east-1"
-	peer_account_id = data.aws_caller_identity.current.account_id
+	peer_vpc_id      = aws_vpc.app.id
+	peer_cidr_blocks = [aws_vpc.app.cidr_block]
+	peer_region      = "us-east-1"
+	peer_account_id  = data.aws_caller_identity.current.account_id
 
 	allow_cql = true
+
+	# Make sure the request is visible on the AWS side before accepting it.
+	wait_for_status = "PENDING_ACCEPTANCE"
 }
 
 resource "aws_vpc_peering_connection_accepter" "app" {
@@ -26,7 +29,7 @@ resource "aws_route_table" "app" {
 	vpc_id = aws_vpc.app.id
 
 	route {
-		cidr_block = scylladbcloud_cluster.example.cidr_block
+		cidr_block = scylladbcloud_vpc_peering.example.scylla_cidr_block
 		vpc_peering_connection_id = aws_vpc_peering_connection_accepter.app.vpc_peering_connection_id
 	}
 }
@@ -0,0 +1,7 @@
+import {
+  to = scylladbcloud_vpc_peering.example
+  identity = {
+    cluster_id    = 1337
+    connection_id = "pcx-1234"
+  }
+}
@@ -2,10 +2,12 @@ package allowlistrule
 
 import (
 	"context"
+	"fmt"
+	"net/netip"
 	"strconv"
-	"strings"
 	"time"
 
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/schemautils"
 	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
 	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
 
@@ -28,7 +30,24 @@ func ResourceAllowlistRule() *schema.Resource {
 		DeleteContext: resourceAllowlistRuleDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceAllowlistRuleImport,
+		},
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"cluster_id": {
+						Description:       "Cluster ID",
+						Type:              schema.TypeInt,
+						RequiredForImport: true,
+					},
+					"rule_id": {
+						Description:       "Rule ID",
+						Type:              schema.TypeInt,
+						RequiredForImport: true,
+					},
+				}
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -37,6 +56,8 @@ func ResourceAllowlistRule() *schema.Resource {
 			Delete: schema.DefaultTimeout(allowlistRuleDeleteTimeout),
 		},
 
+		CustomizeDiff: resourceAllowlistRuleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Description: "Cluster ID",
@@ -45,10 +66,14 @@ func ResourceAllowlistRule() *schema.Resource {
 				Type: schema.TypeInt,
 			},
 			"cidr_block": {
-				Description: "Allowlisted CIDR block",
-				Required:    true,
-				ForceNew:    true,
-				Type:        schema.TypeString,
+				Description: "Allowlisted CIDR block. IPv4 and IPv6 blocks are accepted; a bare address " +
+					"is treated as a single host (/32 or /128). Equivalent spellings of the same network, " +
+					"such as 10.0.0.1/24 and 10.0.0.0/24, do not produce a diff.",
+				Required:         true,
+				ForceNew:         true,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: schemautils.ValidateCIDRDiag,
+				DiffSuppressFunc: schemautils.SuppressEquivalentCIDRDiff,
 			},
 			"rule_id": {
 				Description: "Rule ID",
@@ -59,28 +84,85 @@ func ResourceAllowlistRule() *schema.Resource {
 	}
 }
 
-func resourceAllowlistRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+// resourceAllowlistRuleCustomizeDiff rejects a new rule for a network that is
+// already allowlisted on the cluster. The API would otherwise either fail late
+// in the apply or hand back the existing rule, leaving two resources managing it.
+func resourceAllowlistRuleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("cidr_block") && !d.HasChange("cluster_id") {
+		return nil
+	}
+
+	if !d.NewValueKnown("cluster_id") || !d.NewValueKnown("cidr_block") {
+		return nil
+	}
+
+	c, ok := meta.(*scylla.Client)
+	if !ok || c == nil {
+		return nil
+	}
+
 	var (
-		c         = meta.(*scylla.Client)
-		clusterID = d.Get("cluster_id").(int)
+		clusterID = int64(d.Get("cluster_id").(int))
 		cidrBlock = d.Get("cidr_block").(string)
-		rule      *model.AllowedIP
 	)
 
-	rules, err := c.CreateAllowlistRule(ctx, int64(clusterID), cidrBlock)
+	prefix, err := schemautils.ParseCIDR(cidrBlock)
 	if err != nil {
-		return diag.Errorf("error creating allowlist rule: %s", err)
+		return err
+	}
+
+	rules, err := c.ListAllowlistRules(ctx, clusterID)
+	if err != nil {
+		if scylla.IsNotFound(err) || scylla.IsDeletedErr(err) {
+			return nil // cluster does not exist (yet), the apply reports it
+		}
+		return fmt.Errorf("error reading allowlist rules for cluster ID=%d: %w", clusterID, err)
+	}
+
+	// A replaced rule is deleted before its successor is created, so it must
+	// not count as a duplicate of itself.
+	ruleID, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	if r := findRule(rules, prefix); r != nil && r.ID != ruleID {
+		return fmt.Errorf(
+			"%q is already allowlisted on cluster %d as %q (rule ID %d); import the existing rule instead",
+			cidrBlock, clusterID, r.Address, r.ID,
+		)
 	}
 
+	return nil
+}
+
+// findRule returns the rule allowlisting the given network, or nil.
+func findRule(rules []model.AllowedIP, prefix netip.Prefix) *model.AllowedIP {
 	for i := range rules {
 		r := &rules[i]
 
-		if strings.EqualFold(r.Address, cidrBlock) {
-			rule = r
-			break
+		if p, err := schemautils.ParseCIDR(r.Address); err == nil && p == prefix {
+			return r
 		}
 	}
+	return nil
+}
+
+func resourceAllowlistRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		c         = meta.(*scylla.Client)
+		clusterID = d.Get("cluster_id").(int)
+		cidrBlock = d.Get("cidr_block").(string)
+	)
+
+	prefix, err := schemautils.ParseCIDR(cidrBlock)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rules, err := c.CreateAllowlistRule(ctx, int64(clusterID), prefix.String())
+	if err != nil {
+		return diag.Errorf("error creating allowlist rule: %s", err)
+	}
 
+	rule := findRule(rules, prefix)
 	if rule == nil {
 		return diag.Errorf("unable to find allowlist rule for %q cidr block", cidrBlock)
 	}
@@ -88,7 +170,7 @@ func resourceAllowlistRuleCreate(ctx context.Context, d *schema.ResourceData, me
 	d.SetId(strconv.Itoa(int(rule.ID)))
 	_ = d.Set("rule_id", rule.ID)
 
-	return nil
+	return diag.FromErr(setAllowlistRuleIdentity(d, int64(clusterID), rule.ID))
 }
 
 func resourceAllowlistRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -136,7 +218,31 @@ lookup:
 	_ = d.Set("cidr_block", rule.Address)
 	_ = d.Set("cluster_id", cluster.ID)
 
-	return nil
+	return diag.FromErr(setAllowlistRuleIdentity(d, cluster.ID, rule.ID))
+}
+
+// resourceAllowlistRuleImport imports a rule by its ID or, when imported with
+// an identity, by the rule_id identity attribute.
+func resourceAllowlistRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if d.Id() == "" {
+		identity, err := d.Identity()
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(strconv.Itoa(identity.Get("rule_id").(int)))
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func setAllowlistRuleIdentity(d *schema.ResourceData, clusterID, ruleID int64) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+	if err := identity.Set("cluster_id", clusterID); err != nil {
+		return err
+	}
+	return identity.Set("rule_id", ruleID)
 }
 
 func resourceAllowlistRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
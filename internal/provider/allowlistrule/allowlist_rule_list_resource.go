@@ -0,0 +1,137 @@
+package allowlistrule
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/cluster"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/providerdata"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+)
+
+var (
+	_ list.ListResourceWithConfigure    = (*allowlistRuleListResource)(nil)
+	_ list.ListResourceWithRawV5Schemas = (*allowlistRuleListResource)(nil)
+)
+
+// allowlistRuleListResource lists the allowlist rules of one or all clusters
+// of the account for "terraform query".
+type allowlistRuleListResource struct {
+	client *scylla.Client
+}
+
+type allowlistRuleListModel struct {
+	ClusterID types.Int64 `tfsdk:"cluster_id"`
+}
+
+func NewAllowlistRuleListResource() list.ListResource {
+	return &allowlistRuleListResource{}
+}
+
+func (r *allowlistRuleListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_allowlist_rule"
+}
+
+func (r *allowlistRuleListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	res := ResourceAllowlistRule()
+	resp.ProtoV5Schema = res.ProtoSchema(ctx)()
+	resp.ProtoV5IdentitySchema = res.ProtoIdentitySchema(ctx)()
+}
+
+func (r *allowlistRuleListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the allowlist rules of the clusters of the account.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.Int64Attribute{
+				Description: "Only list the rules of this cluster. Defaults to all clusters that are not deleted.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *allowlistRuleListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var diags diag.Diagnostics
+	r.client, diags = providerdata.Client(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *allowlistRuleListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var m allowlistRuleListModel
+
+	if diags := req.Config.Get(ctx, &m); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if r.client == nil {
+		stream.Results = list.ListResultsStreamDiagnostics(providerdata.NotConfigured())
+		return
+	}
+
+	clusterIDs := []int64{m.ClusterID.ValueInt64()}
+	if m.ClusterID.IsNull() {
+		var err error
+		if clusterIDs, err = cluster.ListClusterIDs(ctx, r.client); err != nil {
+			var diags diag.Diagnostics
+			diags.AddError("Error reading cluster list", err.Error())
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var n int64
+		for _, clusterID := range clusterIDs {
+			rules, err := r.client.ListAllowlistRules(ctx, clusterID)
+			if err != nil {
+				var result list.ListResult
+				result.Diagnostics.AddError("Error reading allowlist rules",
+					fmt.Sprintf("error reading allowlist rules for cluster ID=%d: %s", clusterID, err))
+				push(result)
+				return
+			}
+
+			for i := range rules {
+				if req.Limit > 0 && n >= req.Limit {
+					return
+				}
+				n++
+
+				if !push(allowlistRuleListResult(ctx, req, clusterID, &rules[i])) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func allowlistRuleListResult(ctx context.Context, req list.ListRequest, clusterID int64, rule *model.AllowedIP) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = fmt.Sprintf("%s (cluster %d)", rule.Address, clusterID)
+
+	result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("cluster_id"), clusterID)...)
+	result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("rule_id"), rule.ID)...)
+
+	if req.IncludeResource {
+		for name, v := range map[string]any{
+			"id":         strconv.FormatInt(rule.ID, 10),
+			"cluster_id": clusterID,
+			"cidr_block": rule.Address,
+			"rule_id":    rule.ID,
+		} {
+			result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root(name), v)...)
+		}
+	}
+
+	return result
+}
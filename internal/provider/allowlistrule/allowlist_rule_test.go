@@ -0,0 +1,81 @@
+package allowlistrule
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/schemautils"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/scyllatest"
+)
+
+func TestFindRule(t *testing.T) {
+	t.Parallel()
+
+	rules := []model.AllowedIP{
+		{ID: 1, Address: "10.0.0.0/24"},
+		{ID: 2, Address: "192.168.1.7/16"},
+		{ID: 3, Address: "2001:db8::/32"},
+		{ID: 4, Address: "89.74.148.54"},
+		{ID: 5, Address: "garbage"},
+	}
+
+	tests := []struct {
+		name string
+		cidr string
+		want int64
+	}{
+		{name: "exact duplicate", cidr: "10.0.0.0/24", want: 1},
+		{name: "host bits set", cidr: "10.0.0.1/24", want: 1},
+		{name: "host bits set in rule", cidr: "192.168.0.0/16", want: 2},
+		{name: "different prefix length", cidr: "10.0.0.0/25"},
+		{name: "containing network", cidr: "10.0.0.0/16"},
+		{name: "ipv6 duplicate", cidr: "2001:DB8::1/32", want: 3},
+		{name: "ipv6 different prefix length", cidr: "2001:db8::/48"},
+		{name: "bare address", cidr: "89.74.148.54/32", want: 4},
+		{name: "no match", cidr: "172.16.0.0/12"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			prefix, err := schemautils.ParseCIDR(tt.cidr)
+			require.NoError(t, err)
+
+			r := findRule(rules, prefix)
+			if tt.want == 0 {
+				require.Nil(t, r)
+				return
+			}
+			require.NotNil(t, r)
+			require.Equal(t, tt.want, r.ID)
+		})
+	}
+}
+
+func TestResourceAllowlistRuleCreateCanonical(t *testing.T) {
+	t.Parallel()
+
+	c := scyllatest.NewClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/account/7/cluster/42/network/firewall/allowed", r.URL.Path)
+
+		var req map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, map[string]string{"ipAddress": "10.0.0.0/24"}, req)
+
+		_, _ = w.Write([]byte(`{"data":[{"id":9,"clusterId":42,"address":"10.0.0.0/24"}]}`))
+	})
+
+	d := ResourceAllowlistRule().TestResourceData()
+	require.NoError(t, d.Set("cluster_id", 42))
+	require.NoError(t, d.Set("cidr_block", "10.0.0.1/24"))
+
+	require.Empty(t, resourceAllowlistRuleCreate(context.Background(), d, c))
+	require.Equal(t, "9", d.Id())
+	require.Equal(t, 9, d.Get("rule_id"))
+}
@@ -0,0 +1,173 @@
+// Package cloudaccount implements the data source listing the cloud accounts
+// clusters can be deployed to, including customer-owned (BYOA) accounts.
+//
+// There is no resource registering a customer-owned account: the API client
+// has no endpoint for it, nor for the role and trust details the account
+// needs. Accounts are registered in the ScyllaDB Cloud console.
+package cloudaccount
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/metadata"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+)
+
+func DataSourceCloudAccounts() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the cloud accounts clusters can be deployed to. Customer-owned (BYOA) accounts " +
+			"cannot be registered with this provider; register them in the ScyllaDB Cloud console, " +
+			"then look them up with this data source.",
+		ReadContext: dataSourceCloudAccountsRead,
+
+		Schema: map[string]*schema.Schema{
+			"cloud": {
+				Description: "Only return accounts of this cloud provider (AWS or GCP).",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"owner": {
+				Description: "Only return accounts with this owner: `Customer` for your own (BYOA) accounts, " +
+					"`Scylla` for the accounts owned by ScyllaDB.",
+				Optional: true,
+				Type:     schema.TypeString,
+			},
+			"state": {
+				Description: "Only return accounts in this state (e.g. ACTIVE).",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"ids": {
+				Description: "The IDs of the matching accounts, usable as `byoa_id` of `scylladbcloud_cluster` " +
+					"for customer-owned accounts.",
+				Computed: true,
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"accounts": {
+				Description: "The matching accounts, ordered by ID.",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The cloud account ID.",
+						Computed:    true,
+						Type:        schema.TypeInt,
+					},
+					"cloud": {
+						Description: "The cloud provider of the account.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"owner": {
+						Description: "The owner of the account, `Customer` or `Scylla`.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"state": {
+						Description: "The account state.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+				}},
+			},
+		},
+	}
+}
+
+// cloudAccountsFilter selects cloud accounts; empty fields match everything.
+type cloudAccountsFilter struct {
+	CloudProviderID int64
+	Owner           string
+	State           string
+}
+
+func (f *cloudAccountsFilter) match(ca *model.CloudAccount) bool {
+	switch {
+	case f.CloudProviderID != 0 && f.CloudProviderID != ca.CloudProviderID:
+		return false
+	case f.Owner != "" && !strings.EqualFold(f.Owner, ca.Owner):
+		return false
+	case f.State != "" && !strings.EqualFold(f.State, ca.State):
+		return false
+	}
+	return true
+}
+
+func dataSourceCloudAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*scylla.Client)
+
+	m, err := metadata.Cloudmeta(ctx, c)
+	if err != nil {
+		return diag.Errorf("error reading metadata: %s", err)
+	}
+
+	f := cloudAccountsFilter{
+		Owner: d.Get("owner").(string),
+		State: d.Get("state").(string),
+	}
+	if cloud := d.Get("cloud").(string); cloud != "" {
+		p := m.ProviderByName(cloud)
+		if p == nil {
+			return diag.Errorf(`unrecognized value %q for "cloud" attribute`, cloud)
+		}
+		f.CloudProviderID = p.CloudProvider.ID
+	}
+
+	accounts, err := c.ListCloudAccounts(ctx)
+	if err != nil {
+		return diag.Errorf("failed to list cloud accounts: %s", err)
+	}
+
+	matched := filterCloudAccounts(accounts, &f)
+
+	var (
+		ids  = make([]int64, 0, len(matched))
+		flat = make([]map[string]interface{}, 0, len(matched))
+	)
+
+	for _, ca := range matched {
+		var cloud string
+		if p := m.ProviderByID(ca.CloudProviderID); p != nil {
+			cloud = p.CloudProvider.Name
+		}
+
+		ids = append(ids, ca.ID)
+		flat = append(flat, map[string]interface{}{
+			"id":    ca.ID,
+			"cloud": cloud,
+			"owner": ca.Owner,
+			"state": ca.State,
+		})
+	}
+
+	d.SetId(strconv.FormatInt(c.AccountID, 10))
+	_ = d.Set("ids", ids)
+	_ = d.Set("accounts", flat)
+
+	return nil
+}
+
+// filterCloudAccounts returns the accounts matching f, ordered by ID.
+func filterCloudAccounts(accounts []model.CloudAccount, f *cloudAccountsFilter) []model.CloudAccount {
+	var matched []model.CloudAccount
+	for i := range accounts {
+		if f.match(&accounts[i]) {
+			matched = append(matched, accounts[i])
+		}
+	}
+
+	slices.SortFunc(matched, func(lhs, rhs model.CloudAccount) int {
+		return cmp.Compare(lhs.ID, rhs.ID)
+	})
+
+	return matched
+}
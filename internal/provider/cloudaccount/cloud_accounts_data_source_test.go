@@ -0,0 +1,67 @@
+package cloudaccount
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/scyllatest"
+)
+
+func TestDataSourceCloudAccountsRead(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, DataSourceCloudAccounts().InternalValidate(nil, false))
+
+	c := scyllatest.NewClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/account/7/cloud-account", r.URL.Path)
+		_, _ = w.Write([]byte(`{"data":[
+			{"id":1002,"cloudProviderId":1,"owner":"Customer","state":"ACTIVE"},
+			{"id":5,"cloudProviderId":1,"owner":"Scylla","state":"ACTIVE"},
+			{"id":1001,"cloudProviderId":1,"owner":"Customer","state":"DELETED"},
+			{"id":6,"cloudProviderId":2,"owner":"Scylla","state":"ACTIVE"}
+		]}`))
+	})
+	c.Meta = &scylla.Cloudmeta{
+		CloudProviders: []scylla.CloudProvider{
+			{CloudProvider: &model.CloudProvider{ID: 1, Name: "AWS"}},
+			{CloudProvider: &model.CloudProvider{ID: 2, Name: "GCP"}},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]interface{}
+		ids    []interface{}
+	}{
+		{"all", map[string]interface{}{}, []interface{}{5, 6, 1001, 1002}},
+		{"cloud", map[string]interface{}{"cloud": "gcp"}, []interface{}{6}},
+		{"owner", map[string]interface{}{"owner": "customer"}, []interface{}{1001, 1002}},
+		{"byoa active", map[string]interface{}{"owner": "Customer", "state": "ACTIVE"}, []interface{}{1002}},
+		{"none", map[string]interface{}{"cloud": "GCP", "owner": "Customer"}, []interface{}{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, DataSourceCloudAccounts().Schema, tt.config)
+			require.Empty(t, dataSourceCloudAccountsRead(context.Background(), d, c))
+			require.Equal(t, "7", d.Id())
+			require.Equal(t, tt.ids, d.Get("ids"))
+		})
+	}
+
+	d := schema.TestResourceDataRaw(t, DataSourceCloudAccounts().Schema, map[string]interface{}{"owner": "Customer", "state": "ACTIVE"})
+	require.Empty(t, dataSourceCloudAccountsRead(context.Background(), d, c))
+	require.Equal(t, "AWS", d.Get("accounts.0.cloud"))
+	require.Equal(t, "Customer", d.Get("accounts.0.owner"))
+
+	d = schema.TestResourceDataRaw(t, DataSourceCloudAccounts().Schema, map[string]interface{}{"cloud": "Azure"})
+	require.NotEmpty(t, dataSourceCloudAccountsRead(context.Background(), d, c))
+}
@@ -26,12 +26,17 @@ const (
 )
 
 func validateMinNodesDiag(v interface{}, _ cty.Path) diag.Diagnostics {
-	value := v.(int)
-	if value < 3 {
-		return diag.Errorf("min_nodes must be at least 3, got %d", value)
+	return diag.FromErr(ValidateMinNodes(v.(int)))
+}
+
+// ValidateMinNodes checks that n is a valid node count of a Standard
+// cluster: nodes are spread evenly across 3 availability zones.
+func ValidateMinNodes(n int) error {
+	if n < 3 {
+		return fmt.Errorf("min_nodes must be at least 3, got %d", n)
 	}
-	if value%3 != 0 {
-		return diag.Errorf("min_nodes must be divisible by 3, got %d", value)
+	if n%3 != 0 {
+		return fmt.Errorf("min_nodes must be divisible by 3, got %d", n)
 	}
 	return nil
 }
@@ -510,7 +515,19 @@ func ResourceCluster() *schema.Resource {
 		DeleteContext: resourceClusterDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceClusterImport,
+		},
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"cluster_id": {
+						Description:       "Cluster ID",
+						Type:              schema.TypeInt,
+						RequiredForImport: true,
+					},
+				}
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -993,6 +1010,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 	d.SetId(strconv.Itoa(int(cr.ClusterID)))
 	_ = d.Set("request_id", cr.ID)
 
+	if err := setClusterIdentity(d, cr.ClusterID); err != nil {
+		return append(warns, diag.FromErr(err)...)
+	}
+
 	return warns
 }
 
@@ -1035,6 +1056,37 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.Errorf("failed to read cluster %d: %s", clusterID, err)
 	}
 
+	if err := setClusterIdentity(d, cluster.ID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return setClusterState(ctx, d, scyllaClient, cluster)
+}
+
+// resourceClusterImport imports a cluster by its ID or, when imported with
+// an identity, by the cluster_id identity attribute.
+func resourceClusterImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if d.Id() == "" {
+		identity, err := d.Identity()
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(strconv.Itoa(identity.Get("cluster_id").(int)))
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func setClusterIdentity(d *schema.ResourceData, clusterID int64) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+	return identity.Set("cluster_id", clusterID)
+}
+
+// setClusterState populates d from the cluster, looking up the instance type
+// and the CA certificate. It is shared by the resource and the data source.
+func setClusterState(ctx context.Context, d *schema.ResourceData, scyllaClient *scylla.Client, cluster *model.Cluster) diag.Diagnostics {
 	p := scyllaClient.Meta.ProviderByID(cluster.CloudProviderID)
 	if p == nil {
 		return diag.Errorf("unexpected cloud provider %d for cluster %d", cluster.CloudProviderID, cluster.ID)
@@ -1057,7 +1109,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 		instanceExternalID = i.ExternalID
 	}
-	caCert, warns := fetchCACertificate(ctx, scyllaClient, clusterID, d.Get("ca_certificate").(string))
+	caCert, warns := fetchCACertificate(ctx, scyllaClient, cluster.ID, d.Get("ca_certificate").(string))
 	err = setClusterKVs(d, cluster, p.CloudProvider.Name, instanceExternalID, caCert, instances, p)
 	if err != nil {
 		return diag.Errorf("failed to set cluster values for cluster %d: %s", cluster.ID, err)
@@ -0,0 +1,188 @@
+package cluster
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/scyllatest"
+)
+
+func TestActionTimeout(t *testing.T) {
+	t.Parallel()
+
+	d, diags := actionTimeout(types.StringNull())
+	require.Empty(t, diags)
+	require.Equal(t, defaultActionTimeout, d)
+
+	d, diags = actionTimeout(types.StringValue("90s"))
+	require.Empty(t, diags)
+	require.Equal(t, 90*time.Second, d)
+
+	for _, v := range []string{"soon", "-5m", "0s"} {
+		_, diags = actionTimeout(types.StringValue(v))
+		require.True(t, diags.HasError(), v)
+	}
+}
+
+func TestClusterResizeActionValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	a := NewClusterResizeAction().(*clusterResizeAction)
+
+	schemaResp := &action.SchemaResponse{}
+	a.Schema(t.Context(), action.SchemaRequest{}, schemaResp)
+	require.Empty(t, schemaResp.Diagnostics)
+	require.Empty(t, schemaResp.Schema.ValidateImplementation(t.Context()))
+
+	typ := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+
+	tests := []struct {
+		name    string
+		config  map[string]tftypes.Value
+		wantErr string
+	}{
+		{
+			name:   "node count",
+			config: map[string]tftypes.Value{"node_count": tftypes.NewValue(tftypes.Number, 6)},
+		},
+		{
+			name:   "scaling",
+			config: map[string]tftypes.Value{"vcpu_min": tftypes.NewValue(tftypes.Number, 16)},
+		},
+		{
+			name:   "unknown",
+			config: map[string]tftypes.Value{"node_count": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)},
+		},
+		{
+			name:    "missing",
+			wantErr: "Missing attribute",
+		},
+		{
+			name: "conflict",
+			config: map[string]tftypes.Value{
+				"node_count":     tftypes.NewValue(tftypes.Number, 6),
+				"storage_min_gb": tftypes.NewValue(tftypes.Number, 100),
+			},
+			wantErr: "Conflicting attributes",
+		},
+		{
+			name:    "invalid node count",
+			config:  map[string]tftypes.Value{"node_count": tftypes.NewValue(tftypes.Number, 4)},
+			wantErr: "Invalid node count",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			vals := map[string]tftypes.Value{"cluster_id": tftypes.NewValue(tftypes.Number, 42)}
+			for name, attrType := range typ.AttributeTypes {
+				if _, ok := vals[name]; !ok {
+					vals[name] = tftypes.NewValue(attrType, nil)
+				}
+			}
+			for name, v := range tt.config {
+				vals[name] = v
+			}
+
+			resp := &action.ValidateConfigResponse{}
+			a.ValidateConfig(t.Context(), action.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, vals)},
+			}, resp)
+
+			if tt.wantErr == "" {
+				require.Empty(t, resp.Diagnostics)
+				return
+			}
+			require.Len(t, resp.Diagnostics, 1)
+			require.Equal(t, tt.wantErr, resp.Diagnostics[0].Summary())
+		})
+	}
+}
+
+func TestResizeCluster(t *testing.T) {
+	t.Parallel()
+
+	var (
+		resized map[string]any
+		scaling model.Scaling
+	)
+
+	c := scyllatest.NewClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/account/7/cluster/42/resize":
+			require.Equal(t, http.MethodPost, r.Method)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&resized))
+			_, _ = w.Write([]byte(`{"data":{"id":100}}`))
+		case "/account/7/cluster/43/dc/3/scaling":
+			require.Equal(t, http.MethodPut, r.Method)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&scaling))
+			_, _ = w.Write([]byte(`{"data":{"id":101}}`))
+		default:
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+	})
+
+	standard := &model.Cluster{
+		ID:         42,
+		Datacenter: &model.Datacenter{ID: 2, InstanceID: 62},
+		Nodes:      []model.Node{{ID: 1, Status: "ACTIVE"}, {ID: 2, Status: "ACTIVE"}, {ID: 3, Status: "ACTIVE"}},
+	}
+
+	r, err := resizeClusterNodes(t.Context(), c, standard, 3)
+	require.NoError(t, err)
+	require.Nil(t, r)
+
+	r, err = resizeClusterNodes(t.Context(), c, standard, 6)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), r.ID)
+	require.Equal(t, map[string]any{
+		"dcNodes": []any{map[string]any{"dcId": 2.0, "wantedSize": 6.0, "instanceTypeId": 62.0}},
+	}, resized)
+
+	_, err = resizeClusterScaling(t.Context(), c, standard, types.Int64Value(16), types.Int64Null())
+	require.ErrorContains(t, err, "is a Standard cluster")
+
+	xcloud := &model.Cluster{
+		ID:         43,
+		Datacenter: &model.Datacenter{ID: 3},
+		Datacenters: []model.Datacenter{{
+			ID: 3,
+			Scaling: &model.Scaling{
+				Mode:             model.ScalingXCloud,
+				InstanceFamilies: []string{"i8g"},
+				Policies: &model.ScalingPolicies{
+					VCPU:    &model.ScalingVCPUPolicy{Min: 8},
+					Storage: &model.ScalingStoragePolicy{Min: 100, TargetUtilization: 0.8},
+				},
+			},
+		}},
+	}
+
+	_, err = resizeClusterNodes(t.Context(), c, xcloud, 6)
+	require.ErrorContains(t, err, "is an X Cloud cluster")
+
+	r, err = resizeClusterScaling(t.Context(), c, xcloud, types.Int64Value(8), types.Int64Null())
+	require.NoError(t, err)
+	require.Nil(t, r)
+
+	r, err = resizeClusterScaling(t.Context(), c, xcloud, types.Int64Null(), types.Int64Value(500))
+	require.NoError(t, err)
+	require.Equal(t, int64(101), r.ID)
+	require.Equal(t, []string{"i8g"}, scaling.InstanceFamilies)
+	require.Equal(t, int64(8), scaling.Policies.VCPU.Min)
+	require.Equal(t, model.ScalingStoragePolicy{Min: 500, TargetUtilization: 0.8}, *scaling.Policies.Storage)
+
+	// The remote policy is left untouched.
+	require.Equal(t, int64(100), xcloud.Datacenters[0].Scaling.Policies.Storage.Min)
+}
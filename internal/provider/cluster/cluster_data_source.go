@@ -0,0 +1,171 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+)
+
+// dataSourceClusterExcluded lists the resource attributes that only describe
+// how the resource manages the cluster and have no meaning for a lookup.
+var dataSourceClusterExcluded = []string{
+	"backup_retention_days",
+	"request_id",
+}
+
+// dataSourceClusterDescriptions replaces the resource descriptions that
+// explain how to configure an attribute rather than what it reports.
+var dataSourceClusterDescriptions = map[string]string{
+	"availability_zone_ids": "Availability zone IDs where cluster nodes are provisioned.",
+	"byoa_id":               "The ID of the cloud account (BYOA) the cluster is deployed to, if any.",
+	"cidr_block":            "The CIDR block of the cluster network.",
+	"cloud":                 "The cloud provider of the cluster.",
+	"datacenter":            "The cluster datacenter name.",
+	"enable_dns":            "Whether DNS is enabled for the cluster.",
+	"enable_vpc_peering":    "Whether VPC peering is enabled for the cluster.",
+	"encryption_at_rest":    "The database-level encryption at rest configuration of the cluster.",
+	"min_nodes":             "The number of nodes of a Standard cluster, `0` for an X Cloud cluster.",
+	"node_disk_size":        "The disk size in gigabytes of the nodes of a Standard cluster, `0` for an X Cloud cluster.",
+	"node_type":             "The instance type of the nodes of a Standard cluster, empty for an X Cloud cluster.",
+	"region":                "The cloud region the cluster is deployed in.",
+	"scaling":               "The autoscaling policy of an X Cloud cluster.",
+	"scylla_version":        "The Scylla version of the cluster.",
+	"user_api_interface":    "The type of user API interface, CQL or ALTERNATOR.",
+}
+
+func DataSourceCluster() *schema.Resource {
+	s := dataSourceSchema(ResourceCluster().Schema)
+	for _, k := range dataSourceClusterExcluded {
+		delete(s, k)
+	}
+	for k, desc := range dataSourceClusterDescriptions {
+		s[k].Description = desc
+	}
+
+	s["cluster_id"] = &schema.Schema{
+		Description:  "The ID of the cluster to look up. Exactly one of `cluster_id` and `name` must be set.",
+		Optional:     true,
+		Computed:     true,
+		Type:         schema.TypeInt,
+		ExactlyOneOf: []string{"cluster_id", "name"},
+	}
+	s["name"] = &schema.Schema{
+		Description:  "The name of the cluster to look up. It must match exactly one cluster that is not deleted.",
+		Optional:     true,
+		Computed:     true,
+		Type:         schema.TypeString,
+		ExactlyOneOf: []string{"cluster_id", "name"},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceClusterRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*scylla.Client)
+
+	clusterID := int64(d.Get("cluster_id").(int))
+	if clusterID == 0 {
+		var err error
+		if clusterID, err = FindClusterIDByName(ctx, c, d.Get("name").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	cluster, err := c.GetCluster(ctx, clusterID)
+	if err != nil {
+		return diag.Errorf("failed to read cluster %d: %s", clusterID, err)
+	}
+
+	diags := setClusterState(ctx, d, c, cluster)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(strconv.FormatInt(cluster.ID, 10))
+	return diags
+}
+
+// FindClusterIDByName returns the ID of the only cluster named name that is
+// not deleted.
+func FindClusterIDByName(ctx context.Context, c *scylla.Client, name string) (int64, error) {
+	clusters, err := c.ListClusters(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error reading cluster list: %w", err)
+	}
+
+	var ids []int64
+	for _, cluster := range clusters {
+		if cluster.ClusterName == name && !strings.EqualFold(cluster.Status, "DELETED") {
+			ids = append(ids, cluster.ID)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return 0, fmt.Errorf("cluster %q not found", name)
+	case 1:
+		return ids[0], nil
+	default:
+		return 0, fmt.Errorf("cluster name %q is ambiguous, it matches clusters %v; use the cluster ID instead", name, ids)
+	}
+}
+
+// ListClusterIDs returns the IDs of the clusters of the account that are not
+// deleted, in ascending order.
+func ListClusterIDs(ctx context.Context, c *scylla.Client) ([]int64, error) {
+	clusters, err := c.ListClusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error reading cluster list: %w", err)
+	}
+
+	ids := make([]int64, 0, len(clusters))
+	for _, cluster := range clusters {
+		if !strings.EqualFold(cluster.Status, "DELETED") {
+			ids = append(ids, cluster.ID)
+		}
+	}
+	slices.Sort(ids)
+
+	return ids, nil
+}
+
+// dataSourceSchema converts a resource schema into a data source schema where
+// every attribute is computed.
+func dataSourceSchema(rs map[string]*schema.Schema) map[string]*schema.Schema {
+	ds := make(map[string]*schema.Schema, len(rs))
+	for k, v := range rs {
+		ds[k] = dataSourceSchemaAttribute(v)
+	}
+	return ds
+}
+
+func dataSourceSchemaAttribute(rs *schema.Schema) *schema.Schema {
+	ds := &schema.Schema{
+		Description: rs.Description,
+		Computed:    true,
+		Sensitive:   rs.Sensitive,
+		Type:        rs.Type,
+		Set:         rs.Set,
+	}
+
+	switch elem := rs.Elem.(type) {
+	case *schema.Resource:
+		ds.Elem = &schema.Resource{Schema: dataSourceSchema(elem.Schema)}
+	case *schema.Schema:
+		ds.Elem = &schema.Schema{Type: elem.Type}
+	default:
+		ds.Elem = rs.Elem
+	}
+
+	return ds
+}
@@ -0,0 +1,276 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/metadata"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/providerdata"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+)
+
+var (
+	_ list.ListResourceWithConfigure    = (*clusterListResource)(nil)
+	_ list.ListResourceWithRawV5Schemas = (*clusterListResource)(nil)
+)
+
+// clusterListResource lists the clusters of the account for "terraform
+// query". It uses the same filters as the scylladbcloud_clusters data source.
+type clusterListResource struct {
+	client *scylla.Client
+}
+
+type clusterListModel struct {
+	NameRegex        types.String `tfsdk:"name_regex"`
+	Cloud            types.String `tfsdk:"cloud"`
+	Region           types.String `tfsdk:"region"`
+	Status           types.String `tfsdk:"status"`
+	ScyllaVersion    types.String `tfsdk:"scylla_version"`
+	UserAPIInterface types.String `tfsdk:"user_api_interface"`
+}
+
+func NewClusterListResource() list.ListResource {
+	return &clusterListResource{}
+}
+
+func (r *clusterListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster"
+}
+
+func (r *clusterListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	res := ResourceCluster()
+	resp.ProtoV5Schema = res.ProtoSchema(ctx)()
+	resp.ProtoV5IdentitySchema = res.ProtoIdentitySchema(ctx)()
+}
+
+func (r *clusterListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the clusters of the account.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Description: "Regular expression the cluster name must match.",
+				Optional:    true,
+			},
+			"cloud": schema.StringAttribute{
+				Description: "Only list clusters of this cloud provider (AWS or GCP).",
+				Optional:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Only list clusters in this cloud region (e.g. us-east-1).",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Only list clusters with this status (e.g. ACTIVE). Deleted clusters are " +
+					"skipped unless `status` is `DELETED`.",
+				Optional: true,
+			},
+			"scylla_version": schema.StringAttribute{
+				Description: "Only list clusters running this Scylla version (e.g. 2025.1.4).",
+				Optional:    true,
+			},
+			"user_api_interface": schema.StringAttribute{
+				Description: "Only list clusters with this user API interface, CQL or ALTERNATOR.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *clusterListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var diags diag.Diagnostics
+	r.client, diags = providerdata.Client(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *clusterListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var m clusterListModel
+
+	if diags := req.Config.Get(ctx, &m); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if r.client == nil {
+		stream.Results = list.ListResultsStreamDiagnostics(providerdata.NotConfigured())
+		return
+	}
+
+	f := clustersFilter{
+		Cloud:            m.Cloud.ValueString(),
+		Region:           m.Region.ValueString(),
+		Status:           m.Status.ValueString(),
+		ScyllaVersion:    m.ScyllaVersion.ValueString(),
+		UserAPIInterface: m.UserAPIInterface.ValueString(),
+	}
+	if expr := m.NameRegex.ValueString(); expr != "" {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			var diags diag.Diagnostics
+			diags.AddAttributeError(path.Root("name_regex"), "Invalid regular expression", err.Error())
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+		f.NameRegex = re
+	}
+
+	clusters, err := r.client.ListClusters(ctx)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Error reading cluster list", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	summaries := filterClusters(clusters, r.client.Meta, &f)
+
+	var sizer *clusterSizer
+	if req.IncludeResource {
+		meta, err := metadata.Cloudmeta(ctx, r.client)
+		if err != nil {
+			var diags diag.Diagnostics
+			diags.AddError("Error reading metadata", err.Error())
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+		sizer = newClusterSizer(r.client, meta, clusters)
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i := range summaries {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+			if !push(clusterListResult(ctx, req, &summaries[i], sizer)) {
+				return
+			}
+		}
+	}
+}
+
+// clusterSizer resolves the arguments sizing the listed clusters, which the
+// cluster list does not report: min_nodes and node_type of a Standard cluster
+// or the scaling block of an X Cloud cluster.
+type clusterSizer struct {
+	client    *scylla.Client
+	meta      *scylla.Cloudmeta
+	clusters  map[int64]*model.Cluster
+	instances map[[2]int64][]model.CloudProviderInstance // by cloud provider and region ID
+}
+
+func newClusterSizer(c *scylla.Client, meta *scylla.Cloudmeta, clusters []model.Cluster) *clusterSizer {
+	s := &clusterSizer{
+		client:    c,
+		meta:      meta,
+		clusters:  make(map[int64]*model.Cluster, len(clusters)),
+		instances: make(map[[2]int64][]model.CloudProviderInstance),
+	}
+	for i := range clusters {
+		s.clusters[clusters[i].ID] = &clusters[i]
+	}
+	return s
+}
+
+// setSizing sets the sizing arguments of the cluster on the resource object
+// of result. Like Read on import, min_nodes is the number of active nodes.
+func (s *clusterSizer) setSizing(ctx context.Context, result *list.ListResult, clusterID int64) error {
+	cluster := s.clusters[clusterID]
+	if cluster == nil || cluster.Datacenter == nil {
+		return fmt.Errorf("cluster %d has no datacenter", clusterID)
+	}
+
+	p := s.meta.ProviderByID(cluster.CloudProviderID)
+	if p == nil {
+		return fmt.Errorf("unexpected cloud provider %d for cluster %d", cluster.CloudProviderID, clusterID)
+	}
+
+	key := [2]int64{cluster.CloudProviderID, cluster.Datacenter.RegionID}
+	instances, ok := s.instances[key]
+	if !ok {
+		var err error
+		if instances, err = s.client.ListCloudProviderInstancesPerRegion(ctx, key[0], key[1]); err != nil {
+			return fmt.Errorf("failed to list cloud provider instances for cluster %d: %w", clusterID, err)
+		}
+		s.instances[key] = instances
+	}
+
+	if hasScaling(cluster) {
+		scaling, err := flattenScaling(cluster.Datacenter.Scaling, instances, p)
+		if err != nil {
+			return err
+		}
+		result.Diagnostics.Append(setFlattenedBlocks(ctx, result, path.Root("scaling"), scaling)...)
+		return nil
+	}
+
+	i := p.InstanceByIDFromInstances(cluster.Datacenter.InstanceID, instances)
+	if i == nil {
+		return fmt.Errorf("unexpected instance ID for cluster %d: %d", clusterID, cluster.Datacenter.InstanceID)
+	}
+
+	nodes, err := s.client.ListClusterNodes(ctx, clusterID)
+	if err != nil {
+		return fmt.Errorf("error reading nodes of cluster %d: %w", clusterID, err)
+	}
+
+	result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("min_nodes"), len(model.NodesByStatus(nodes, "ACTIVE")))...)
+	result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("node_type"), i.ExternalID)...)
+	return nil
+}
+
+// setFlattenedBlocks sets nested blocks flattened for the SDK, e.g. by
+// flattenScaling, on the resource object of result.
+func setFlattenedBlocks(ctx context.Context, result *list.ListResult, p path.Path, blocks []map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for i, block := range blocks {
+		for name, v := range block {
+			if nested, ok := v.([]map[string]interface{}); ok {
+				diags.Append(setFlattenedBlocks(ctx, result, p.AtListIndex(i).AtName(name), nested)...)
+				continue
+			}
+			diags.Append(result.Resource.SetAttribute(ctx, p.AtListIndex(i).AtName(name), v)...)
+		}
+	}
+	return diags
+}
+
+func clusterListResult(ctx context.Context, req list.ListRequest, s *clusterSummary, sizer *clusterSizer) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = s.Name
+
+	result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("cluster_id"), s.ID)...)
+
+	if req.IncludeResource {
+		for name, v := range map[string]any{
+			"id":                 strconv.FormatInt(s.ID, 10),
+			"cluster_id":         s.ID,
+			"name":               s.Name,
+			"cloud":              s.Cloud,
+			"region":             s.Region,
+			"status":             s.Status,
+			"scylla_version":     s.ScyllaVersion,
+			"user_api_interface": s.UserAPIInterface,
+			"datacenter":         s.Datacenter,
+			"cidr_block":         s.CIDRBlock,
+			"enable_dns":         s.DNS,
+			"enable_vpc_peering": s.VPCPeering,
+		} {
+			result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root(name), v)...)
+		}
+
+		if err := sizer.setSizing(ctx, &result, s.ID); err != nil {
+			result.Diagnostics.AddError("Error reading cluster sizing", err.Error())
+		}
+	}
+
+	return result
+}
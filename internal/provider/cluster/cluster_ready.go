@@ -0,0 +1,264 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/cqlauth"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+)
+
+const (
+	clusterReadyTimeout     = 60 * time.Minute
+	clusterReadyDialTimeout = 5 * time.Second
+)
+
+func ResourceClusterReady() *schema.Resource {
+	return &schema.Resource{
+		Description: "Waits until a cluster is ready to serve requests: no cluster request is in progress " +
+			"and all its nodes are active, apart from nodes being removed. Destroying the resource does nothing. " +
+			"Use `triggers` to wait again after each change of the cluster.",
+
+		CreateContext: resourceClusterReadyCreate,
+		ReadContext:   resourceClusterReadyRead,
+		DeleteContext: resourceClusterReadyDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(clusterReadyTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Description: "The ID of the cluster to wait for.",
+				Required:    true,
+				ForceNew:    true,
+				Type:        schema.TypeInt,
+			},
+			"triggers": {
+				Description: "Arbitrary values that, when changed, make the resource wait for the cluster again. " +
+					"Typically set to attributes of `scylladbcloud_cluster` that change with the cluster, " +
+					"e.g. `node_count` or `node_type`.",
+				Optional: true,
+				ForceNew: true,
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"check_cql": {
+				Description: "Also wait until the CQL port of every seed node accepts TCP connections. " +
+					"The seeds must be reachable from where Terraform runs.",
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+				Type:     schema.TypeBool,
+			},
+			"cql_port": {
+				Description:  "The CQL port checked when `check_cql` is set.",
+				Optional:     true,
+				Default:      9042,
+				ForceNew:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"cql_address_type": {
+				Description: "The seed addresses checked when `check_cql` is set: `DNS`, `PUBLIC_IP` or `PRIVATE_IP`.",
+				Optional:    true,
+				Default:     cqlauth.AddressDNS,
+				ForceNew:    true,
+				Type:        schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					cqlauth.AddressDNS, cqlauth.AddressPublic, cqlauth.AddressPrivate,
+				}, false),
+			},
+			"node_count": {
+				Description: "The number of active nodes the cluster had when it became ready.",
+				Computed:    true,
+				Type:        schema.TypeInt,
+			},
+			"ready_at": {
+				Description: "The time the cluster became ready, in RFC 3339 format.",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceClusterReadyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		c         = meta.(*scylla.Client)
+		clusterID = int64(d.Get("cluster_id").(int))
+	)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	tflog.Debug(ctx, "waiting for cluster requests to finish", map[string]interface{}{"cluster_id": clusterID})
+
+	if err := WaitForNoInProgressRequests(ctx, c, clusterID); err != nil {
+		return diag.Errorf("failed to wait for requests of cluster %d to finish: %s", clusterID, err)
+	}
+
+	tflog.Debug(ctx, "waiting for cluster nodes to become active", map[string]interface{}{"cluster_id": clusterID})
+
+	nodes, err := waitForActiveNodes(ctx, c, clusterID)
+	if err != nil {
+		return diag.Errorf("failed to wait for nodes of cluster %d to become active: %s", clusterID, err)
+	}
+
+	if d.Get("check_cql").(bool) {
+		conn, err := c.Connect(ctx, clusterID)
+		if err != nil {
+			return diag.Errorf("error reading connection details: %s", err)
+		}
+
+		addrs, err := seedAddresses(conn, d.Get("cql_address_type").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		port := strconv.Itoa(d.Get("cql_port").(int))
+		for _, addr := range addrs {
+			if err := waitForTCP(ctx, net.JoinHostPort(addr, port)); err != nil {
+				return diag.Errorf("failed to wait for the CQL port of cluster %d: %s", clusterID, err)
+			}
+		}
+	}
+
+	d.SetId(strconv.FormatInt(clusterID, 10))
+	_ = d.Set("node_count", len(nodes))
+	_ = d.Set("ready_at", time.Now().UTC().Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceClusterReadyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*scylla.Client)
+
+	clusterID, diags := parseClusterID(d)
+	if diags != nil {
+		return diags
+	}
+
+	// The readiness is only checked on create; forget the resource when the
+	// cluster is gone so that a recreated cluster is waited for again.
+	if _, err := c.ListClusterNodes(ctx, clusterID); scylla.IsDeletedErr(err) || scylla.IsNotFound(err) {
+		d.SetId("")
+	} else if err != nil {
+		return diag.Errorf("failed to list nodes of cluster %d: %s", clusterID, err)
+	}
+
+	return nil
+}
+
+func resourceClusterReadyDelete(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return nil
+}
+
+// nodeGoneStatuses are the statuses of nodes that are leaving or have left the
+// cluster. Such nodes never become active, so they are not waited for.
+var nodeGoneStatuses = []string{
+	"DELETING",
+	"DELETED",
+	"DECOMMISSIONING",
+	"DECOMMISSIONED",
+	"TERMINATING",
+	"TERMINATED",
+}
+
+// waitForActiveNodes waits until the cluster has active nodes and none is
+// still being provisioned, and returns the active nodes.
+func waitForActiveNodes(ctx context.Context, c *scylla.Client, clusterID int64) ([]model.Node, error) {
+	t := time.NewTicker(clusterPollInterval)
+	defer t.Stop()
+
+	for {
+		nodes, err := c.ListClusterNodes(ctx, clusterID)
+		if err != nil {
+			return nil, err
+		}
+
+		active, pending := splitNodes(nodes)
+		if len(active) > 0 && len(pending) == 0 {
+			return active, nil
+		}
+
+		tflog.Trace(ctx, "cluster nodes not active yet", map[string]interface{}{
+			"cluster_id": clusterID,
+			"pending":    pending,
+		})
+
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// splitNodes returns the active nodes and describes the nodes that are still
+// being provisioned. Nodes leaving the cluster are in neither.
+func splitNodes(nodes []model.Node) (active []model.Node, pending []string) {
+	for i := range nodes {
+		n := &nodes[i]
+		switch {
+		case strings.EqualFold(n.Status, "ACTIVE"):
+			active = append(active, *n)
+		case slices.ContainsFunc(nodeGoneStatuses, func(s string) bool { return strings.EqualFold(s, n.Status) }):
+		default:
+			pending = append(pending, fmt.Sprintf("%d (%s)", n.ID, n.Status))
+		}
+	}
+	return active, pending
+}
+
+// seedAddresses returns the seed addresses of all datacenters of the given
+// address type.
+func seedAddresses(conn *model.ClusterConnectionInformation, addrType string) ([]string, error) {
+	var addrs []string
+	for i := range conn.Datacenters {
+		dc := &conn.Datacenters[i]
+		dcAddrs := cqlauth.DCAddresses(dc, addrType)
+		if len(dcAddrs) == 0 {
+			return nil, fmt.Errorf("datacenter %q has no %s seed addresses", dc.Name, addrType)
+		}
+		addrs = append(addrs, dcAddrs...)
+	}
+	if len(addrs) == 0 {
+		return nil, errors.New("error reading datacenter connections: not found")
+	}
+	return addrs, nil
+}
+
+// waitForTCP waits until addr accepts TCP connections.
+func waitForTCP(ctx context.Context, addr string) error {
+	t := time.NewTicker(clusterPollInterval)
+	defer t.Stop()
+
+	d := net.Dialer{Timeout: clusterReadyDialTimeout}
+	for {
+		conn, err := d.DialContext(ctx, "tcp", addr)
+		if err == nil {
+			return conn.Close()
+		}
+
+		tflog.Trace(ctx, "CQL port not reachable yet", map[string]interface{}{"address": addr, "error": err.Error()})
+
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return fmt.Errorf("%s: %w (last error: %s)", addr, ctx.Err(), err)
+		}
+	}
+}
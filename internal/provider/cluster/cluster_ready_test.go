@@ -0,0 +1,114 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/cqlauth"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/scyllatest"
+)
+
+func TestSplitNodes(t *testing.T) {
+	t.Parallel()
+
+	nodes := []model.Node{
+		{ID: 1, Status: "ACTIVE"},
+		{ID: 2, Status: "QUEUED"},
+		{ID: 3, Status: "active"},
+		{ID: 4, Status: "DELETED"},
+		{ID: 5, Status: "decommissioning"},
+		{ID: 6, Status: "PROVISIONING"},
+	}
+
+	active, pending := splitNodes(nodes)
+	require.Equal(t, []model.Node{nodes[0], nodes[2]}, active)
+	require.Equal(t, []string{"2 (QUEUED)", "6 (PROVISIONING)"}, pending)
+
+	active, pending = splitNodes(nodes[3:5])
+	require.Empty(t, active)
+	require.Empty(t, pending)
+}
+
+func TestSeedAddresses(t *testing.T) {
+	t.Parallel()
+
+	conn := &model.ClusterConnectionInformation{
+		Datacenters: []model.DatacenterConnection{
+			{Name: "AWS_US_EAST_1", DNS: []string{"node-0.example.com"}, PublicIP: []string{"3.3.3.3"}},
+			{Name: "AWS_EU_WEST_1", DNS: []string{"node-3.example.com"}, PublicIP: []string{"4.4.4.4"}},
+		},
+	}
+
+	addrs, err := seedAddresses(conn, cqlauth.AddressDNS)
+	require.NoError(t, err)
+	require.Equal(t, []string{"node-0.example.com", "node-3.example.com"}, addrs)
+
+	addrs, err = seedAddresses(conn, cqlauth.AddressPublic)
+	require.NoError(t, err)
+	require.Equal(t, []string{"3.3.3.3", "4.4.4.4"}, addrs)
+
+	_, err = seedAddresses(conn, cqlauth.AddressPrivate)
+	require.ErrorContains(t, err, `datacenter "AWS_US_EAST_1" has no PRIVATE_IP seed addresses`)
+
+	_, err = seedAddresses(&model.ClusterConnectionInformation{}, cqlauth.AddressDNS)
+	require.Error(t, err)
+}
+
+func TestResourceClusterReadyCreate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ResourceClusterReady().InternalValidate(nil, true))
+
+	cql, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = cql.Close() })
+
+	c := scyllatest.NewClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/account/7/cluster/42/request":
+			require.Equal(t, "IN_PROGRESS", r.URL.Query().Get("status"))
+			_, _ = w.Write([]byte(`{"data":[]}`))
+		case "/account/7/cluster/42/nodes":
+			_, _ = w.Write([]byte(`{"data":{"nodes":[{"id":1,"status":"ACTIVE"},{"id":2,"status":"ACTIVE"},{"id":3,"status":"ACTIVE"},{"id":4,"status":"DELETED"}]}}`))
+		case "/account/7/cluster/connect":
+			_, _ = w.Write([]byte(`{"data":{"broadcastType":"PUBLIC","connectDataCenters":[{"dcName":"AWS_US_EAST_1","publicIPs":["127.0.0.1"]}]}}`))
+		default:
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, ResourceClusterReady().Schema, map[string]interface{}{
+		"cluster_id":       42,
+		"check_cql":        true,
+		"cql_port":         cql.Addr().(*net.TCPAddr).Port,
+		"cql_address_type": cqlauth.AddressPublic,
+		"triggers":         map[string]interface{}{"node_count": "3"},
+	})
+	require.Empty(t, resourceClusterReadyCreate(context.Background(), d, c))
+	require.Equal(t, "42", d.Id())
+	require.Equal(t, 3, d.Get("node_count"))
+	require.NotEmpty(t, d.Get("ready_at"))
+}
+
+func TestWaitForTCPCanceled(t *testing.T) {
+	t.Parallel()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.Addr().String()
+	require.NoError(t, l.Close())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = waitForTCP(ctx, addr)
+	require.ErrorIs(t, err, context.Canceled)
+	require.ErrorContains(t, err, fmt.Sprintf("%s: ", addr))
+}
@@ -0,0 +1,166 @@
+package cluster
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+)
+
+func DataSourceClusterRequests() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClusterRequestsRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Description: "The ID of the cluster.",
+				Required:    true,
+				Type:        schema.TypeInt,
+			},
+			"type": {
+				Description: "Only return requests of this type (e.g. CREATE_CLUSTER, ADD_DC, RESIZE_CLUSTER_V2, DELETE_CLUSTER).",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"status": {
+				Description: "Only return requests with this status (e.g. IN_PROGRESS, COMPLETED, FAILED).",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"ids": {
+				Description: "The IDs of the matching requests, oldest first.",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"requests": {
+				Description: "The matching requests, oldest first.",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"request_id": {
+						Description: "The request ID.",
+						Computed:    true,
+						Type:        schema.TypeInt,
+					},
+					"type": {
+						Description: "The request type.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"status": {
+						Description: "The request status.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"progress_percent": {
+						Description: "The request progress in percent.",
+						Computed:    true,
+						Type:        schema.TypeInt,
+					},
+					"progress_description": {
+						Description: "The description of the current request step.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"user_id": {
+						Description: "The ID of the user who made the request.",
+						Computed:    true,
+						Type:        schema.TypeInt,
+					},
+					"request_body": {
+						Description: "The body the request was made with, as a JSON document. Use `jsondecode` to read its fields.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+				}},
+			},
+		},
+	}
+}
+
+func dataSourceClusterRequestsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		c         = meta.(*scylla.Client)
+		clusterID = int64(d.Get("cluster_id").(int))
+		params    = scylla.ListClusterRequestParams{
+			Type:   strings.ToUpper(d.Get("type").(string)),
+			Status: strings.ToUpper(d.Get("status").(string)),
+		}
+	)
+
+	reqs, err := c.ListClusterRequest(ctx, clusterID, params)
+	if err != nil {
+		return diag.Errorf("failed to list cluster requests for cluster %d: %s", clusterID, err)
+	}
+
+	slices.SortFunc(reqs, func(lhs, rhs model.ClusterRequest) int {
+		return cmp.Compare(lhs.ID, rhs.ID)
+	})
+
+	var (
+		ids  = make([]int64, 0, len(reqs))
+		flat = make([]map[string]interface{}, 0, len(reqs))
+	)
+
+	for _, req := range reqs {
+		// The list may lag behind on the progress of running requests.
+		if !isFinishedClusterRequest(req.Status) {
+			fresh, err := c.GetClusterRequest(ctx, req.ID)
+			if err != nil {
+				return diag.Errorf("failed to read cluster request %d: %s", req.ID, err)
+			}
+			req = fresh
+		}
+
+		ids = append(ids, req.ID)
+		flat = append(flat, map[string]interface{}{
+			"request_id":           req.ID,
+			"type":                 req.RequestType,
+			"status":               req.Status,
+			"progress_percent":     req.ProgressPercent,
+			"progress_description": req.ProgressDescription,
+			"user_id":              req.UserID,
+			"request_body":         decodeRequestBody(req.RequestBody),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s/%s", clusterID, params.Type, params.Status))
+	_ = d.Set("ids", ids)
+	_ = d.Set("requests", flat)
+
+	return nil
+}
+
+func isFinishedClusterRequest(status string) bool {
+	switch strings.ToUpper(status) {
+	case "COMPLETED", "FAILED", "CANCELED", "CANCELLED":
+		return true
+	}
+	return false
+}
+
+// decodeRequestBody returns the request body as compact JSON. Some requests
+// store the body as a JSON-encoded string, which is unwrapped first. Bodies
+// that are not JSON are returned unchanged.
+func decodeRequestBody(body string) string {
+	var s string
+	if err := json.Unmarshal([]byte(body), &s); err == nil {
+		body = s
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(body)); err != nil {
+		return body
+	}
+	return buf.String()
+}
@@ -0,0 +1,69 @@
+package cluster
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/scyllatest"
+)
+
+func TestDecodeRequestBody(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"object", `{ "clusterName": "prod",  "wantedSize": 6 }`, `{"clusterName":"prod","wantedSize":6}`},
+		{"encoded object", `"{\"clusterName\": \"prod\"}"`, `{"clusterName":"prod"}`},
+		{"not json", `clusterName=prod`, `clusterName=prod`},
+		{"empty", ``, ``},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, decodeRequestBody(tt.body))
+		})
+	}
+}
+
+func TestDataSourceClusterRequestsRead(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, DataSourceClusterRequests().InternalValidate(nil, false))
+
+	c := scyllatest.NewClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/account/7/cluster/42/request":
+			require.Equal(t, "RESIZE_CLUSTER_V2", r.URL.Query().Get("type"))
+			_, _ = w.Write([]byte(`{"data":[
+				{"id":12,"requestType":"RESIZE_CLUSTER_V2","userID":3,"status":"IN_PROGRESS","progressPercent":10,"requestBody":"{}"},
+				{"id":11,"requestType":"RESIZE_CLUSTER_V2","userID":3,"status":"COMPLETED","progressPercent":100,"requestBody":"{\"dcNodes\": []}"}
+			]}`))
+		case "/account/7/cluster/request/12":
+			_, _ = w.Write([]byte(`{"data":{"id":12,"requestType":"RESIZE_CLUSTER_V2","userID":3,"status":"IN_PROGRESS",` +
+				`"progressPercent":40,"progressDescription":"Adding nodes","requestBody":"{}"}}`))
+		default:
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, DataSourceClusterRequests().Schema, map[string]interface{}{
+		"cluster_id": 42,
+		"type":       "resize_cluster_v2",
+	})
+	require.Empty(t, dataSourceClusterRequestsRead(context.Background(), d, c))
+	require.Equal(t, "42/RESIZE_CLUSTER_V2/", d.Id())
+	require.Equal(t, []interface{}{11, 12}, d.Get("ids"))
+	require.Equal(t, `{"dcNodes":[]}`, d.Get("requests.0.request_body"))
+	require.Equal(t, 100, d.Get("requests.0.progress_percent"))
+	require.Equal(t, 40, d.Get("requests.1.progress_percent"))
+	require.Equal(t, "Adding nodes", d.Get("requests.1.progress_description"))
+	require.Equal(t, 3, d.Get("requests.1.user_id"))
+}
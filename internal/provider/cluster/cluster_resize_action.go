@@ -0,0 +1,250 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/providerdata"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+)
+
+var (
+	_ action.ActionWithConfigure      = (*clusterResizeAction)(nil)
+	_ action.ActionWithValidateConfig = (*clusterResizeAction)(nil)
+)
+
+// clusterResizeAction resizes a cluster outside of its desired state: a
+// Standard cluster is resized to a node count, an X Cloud cluster gets new
+// minimums in its scaling policy. The scylladbcloud_cluster resource picks
+// the new size up on its next refresh.
+type clusterResizeAction struct {
+	client *scylla.Client
+}
+
+type clusterResizeModel struct {
+	ClusterID    types.Int64  `tfsdk:"cluster_id"`
+	NodeCount    types.Int64  `tfsdk:"node_count"`
+	VCPUMin      types.Int64  `tfsdk:"vcpu_min"`
+	StorageMinGB types.Int64  `tfsdk:"storage_min_gb"`
+	Timeout      types.String `tfsdk:"timeout"`
+}
+
+func NewClusterResizeAction() action.Action {
+	return &clusterResizeAction{}
+}
+
+func (a *clusterResizeAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_resize"
+}
+
+func (a *clusterResizeAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resizes a cluster and waits for the resize to complete. Set `node_count` for a Standard " +
+			"cluster, or `vcpu_min` and/or `storage_min_gb` to update the scaling policy of an X Cloud cluster.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.Int64Attribute{
+				Description: "Cluster ID",
+				Required:    true,
+			},
+			"node_count": schema.Int64Attribute{
+				Description: "Number of nodes of a Standard cluster; must be at least 3 and divisible by 3.",
+				Optional:    true,
+			},
+			"vcpu_min": schema.Int64Attribute{
+				Description: "Minimum number of vCPUs of an X Cloud cluster.",
+				Optional:    true,
+			},
+			"storage_min_gb": schema.Int64Attribute{
+				Description: "Minimum storage of an X Cloud cluster, in GB.",
+				Optional:    true,
+			},
+			"timeout": schema.StringAttribute{
+				Description: "How long to wait for the resize, as a duration such as `30m`. Defaults to `60m`.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *clusterResizeAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	var diags diag.Diagnostics
+	a.client, diags = providerdata.Client(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (a *clusterResizeAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var m clusterResizeModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &m)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are validated when the action is invoked.
+	if m.NodeCount.IsUnknown() || m.VCPUMin.IsUnknown() || m.StorageMinGB.IsUnknown() {
+		return
+	}
+
+	xcloud := !m.VCPUMin.IsNull() || !m.StorageMinGB.IsNull()
+
+	switch {
+	case !m.NodeCount.IsNull() && xcloud:
+		resp.Diagnostics.AddAttributeError(path.Root("node_count"), "Conflicting attributes",
+			`"node_count" cannot be combined with "vcpu_min" or "storage_min_gb"`)
+	case m.NodeCount.IsNull() && !xcloud:
+		resp.Diagnostics.AddError("Missing attribute",
+			`one of "node_count", "vcpu_min" or "storage_min_gb" must be set`)
+	case !m.NodeCount.IsNull():
+		if err := ValidateMinNodes(int(m.NodeCount.ValueInt64())); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("node_count"), "Invalid node count", err.Error())
+		}
+	}
+}
+
+func (a *clusterResizeAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var m clusterResizeModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &m)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if a.client == nil {
+		resp.Diagnostics.Append(providerdata.NotConfigured()...)
+		return
+	}
+
+	timeout, diags := actionTimeout(m.Timeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	clusterID := m.ClusterID.ValueInt64()
+
+	// Resize will fail if there is any ongoing cluster request.
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for the requests in progress of cluster %d", clusterID),
+	})
+	if err := WaitForNoInProgressRequests(ctx, a.client, clusterID); err != nil {
+		resp.Diagnostics.AddError("Error waiting for cluster requests",
+			fmt.Sprintf("failed waiting for no in-progress cluster requests for cluster %d: %s", clusterID, err))
+		return
+	}
+
+	cluster, err := a.client.GetCluster(ctx, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading cluster", fmt.Sprintf("failed to get the cluster with ID %d: %s", clusterID, err))
+		return
+	}
+
+	var request *model.ClusterRequest
+	if !m.NodeCount.IsNull() {
+		request, err = resizeClusterNodes(ctx, a.client, cluster, int(m.NodeCount.ValueInt64()))
+	} else {
+		request, err = resizeClusterScaling(ctx, a.client, cluster, m.VCPUMin, m.StorageMinGB)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error resizing cluster", err.Error())
+		return
+	}
+	if request == nil {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Cluster %d already has the requested size", clusterID),
+		})
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for the resize request %d of cluster %d", request.ID, clusterID),
+	})
+	if err := WaitForClusterRequestID(ctx, a.client, request.ID); err != nil {
+		resp.Diagnostics.AddError("Error waiting for cluster resize",
+			fmt.Sprintf("failed waiting for the cluster resize with ID %d for the cluster %d: %s", request.ID, clusterID, err))
+	}
+}
+
+// resizeClusterNodes requests a resize of a Standard cluster to n nodes. It
+// returns a nil request when the cluster already has n active nodes.
+func resizeClusterNodes(ctx context.Context, c *scylla.Client, cluster *model.Cluster, n int) (*model.ClusterRequest, error) {
+	if err := ValidateMinNodes(n); err != nil {
+		return nil, err
+	}
+	if hasScaling(cluster) {
+		return nil, fmt.Errorf(`cluster %d is an X Cloud cluster, set "vcpu_min" or "storage_min_gb" instead of "node_count"`, cluster.ID)
+	}
+	if cluster.Datacenter == nil {
+		return nil, fmt.Errorf("cluster %d has no datacenter", cluster.ID)
+	}
+
+	if len(model.NodesByStatus(cluster.Nodes, "ACTIVE")) == n {
+		return nil, nil
+	}
+
+	r, err := c.ResizeCluster(ctx, cluster.ID, cluster.Datacenter.ID, cluster.Datacenter.InstanceID, n)
+	if err != nil {
+		return nil, fmt.Errorf("error resizing cluster: %w", err)
+	}
+	return r, nil
+}
+
+// resizeClusterScaling updates the minimums of the scaling policy of an X
+// Cloud cluster, keeping the rest of the policy. It returns a nil request
+// when the policy already has the requested minimums.
+func resizeClusterScaling(ctx context.Context, c *scylla.Client, cluster *model.Cluster, vcpuMin, storageMinGB types.Int64) (*model.ClusterRequest, error) {
+	if !hasScaling(cluster) {
+		return nil, fmt.Errorf(`cluster %d is a Standard cluster, set "node_count" instead of "vcpu_min" or "storage_min_gb"`, cluster.ID)
+	}
+	if cluster.Datacenter == nil {
+		return nil, fmt.Errorf("cluster %d has no datacenter", cluster.ID)
+	}
+
+	remote := cluster.Datacenter.Scaling
+	if len(cluster.Datacenters) > 0 && cluster.Datacenters[0].Scaling.Enabled() {
+		remote = cluster.Datacenters[0].Scaling
+	}
+	desired := &model.Scaling{
+		Mode:             remote.Mode,
+		InstanceFamilies: remote.InstanceFamilies,
+		InstanceTypeIDs:  remote.InstanceTypeIDs,
+		Policies:         &model.ScalingPolicies{},
+	}
+	if remote.Policies != nil {
+		*desired.Policies = *remote.Policies
+	}
+
+	if !vcpuMin.IsNull() {
+		desired.Policies.VCPU = &model.ScalingVCPUPolicy{Min: vcpuMin.ValueInt64()}
+	}
+	if !storageMinGB.IsNull() {
+		storage := model.ScalingStoragePolicy{}
+		if desired.Policies.Storage != nil {
+			storage = *desired.Policies.Storage
+		}
+		storage.Min = storageMinGB.ValueInt64()
+		desired.Policies.Storage = &storage
+	}
+
+	if isScalingEqual(desired, remote) {
+		return nil, nil
+	}
+
+	r, err := c.UpdateDcScalingPolicy(ctx, cluster.ID, cluster.Datacenter.ID, desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update cluster scaling: %w", err)
+	}
+	if r == nil || r.ID == 0 {
+		return nil, fmt.Errorf("failed to update cluster scaling: missing cluster request ID in response")
+	}
+	return r, nil
+}
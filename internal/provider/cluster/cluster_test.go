@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
 	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/scyllatest"
 	"github.com/stretchr/testify/require"
 )
 
@@ -1217,3 +1218,84 @@ func TestEncryptionAtRestPlan(t *testing.T) {
 		require.ErrorContains(t, err, `invalid "key_id" "deadbeef"`)
 	})
 }
+
+func TestDataSourceClusterSchema(t *testing.T) {
+	t.Parallel()
+
+	ds := DataSourceCluster()
+	require.NoError(t, ds.InternalValidate(nil, false))
+
+	for _, k := range dataSourceClusterExcluded {
+		require.NotContains(t, ds.Schema, k)
+	}
+
+	for k, s := range ds.Schema {
+		require.True(t, s.Computed, k)
+		require.False(t, s.Required, k)
+		require.False(t, s.ForceNew, k)
+		require.Nil(t, s.Default, k)
+	}
+
+	require.True(t, ds.Schema["cluster_id"].Optional)
+	require.True(t, ds.Schema["name"].Optional)
+	require.Contains(t, ds.Schema, "ca_certificate")
+	require.Contains(t, ds.Schema["scaling"].Elem.(*schema.Resource).Schema, "storage_policy")
+}
+
+func TestResourceClusterImportIdentity(t *testing.T) {
+	t.Parallel()
+
+	r := ResourceCluster()
+	require.NoError(t, r.InternalValidate(nil, true))
+
+	d := schema.TestResourceDataWithIdentityRaw(t, r.SchemaMap(), r.Identity.SchemaMap(), map[string]string{"cluster_id": "42"})
+	ds, err := resourceClusterImport(context.Background(), d, nil)
+	require.NoError(t, err)
+	require.Len(t, ds, 1)
+	require.Equal(t, "42", ds[0].Id())
+
+	require.NoError(t, setClusterIdentity(d, 43))
+	identity, err := d.Identity()
+	require.NoError(t, err)
+	require.Equal(t, 43, identity.Get("cluster_id"))
+}
+
+func TestFindClusterIDByName(t *testing.T) {
+	t.Parallel()
+
+	client := scyllatest.NewClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/account/7/clusters", r.URL.Path)
+		_, _ = w.Write([]byte(`{"data":{"clusters":[
+			{"id":1,"clusterName":"prod","status":"ACTIVE"},
+			{"id":2,"clusterName":"dup","status":"ACTIVE"},
+			{"id":3,"clusterName":"dup","status":"BOOTSTRAPPING"},
+			{"id":4,"clusterName":"prod","status":"DELETED"},
+			{"id":5,"clusterName":"gone","status":"deleted"}
+		]}}`))
+	})
+
+	tests := []struct {
+		name    string
+		want    int64
+		wantErr string
+	}{
+		{name: "prod", want: 1},
+		{name: "Prod", wantErr: `cluster "Prod" not found`},
+		{name: "gone", wantErr: `cluster "gone" not found`},
+		{name: "dup", wantErr: `cluster name "dup" is ambiguous, it matches clusters [2 3]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			id, err := FindClusterIDByName(context.Background(), client, tt.name)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, id)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/providerdata"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+)
+
+// defaultActionTimeout bounds the actions waiting for cluster requests.
+const defaultActionTimeout = 60 * time.Minute
+
+var _ action.ActionWithConfigure = (*clusterWaitIdleAction)(nil)
+
+// clusterWaitIdleAction waits until the cluster has no request in progress,
+// e.g. before changes that the API rejects while a resize is running.
+type clusterWaitIdleAction struct {
+	client *scylla.Client
+}
+
+type clusterWaitIdleModel struct {
+	ClusterID types.Int64  `tfsdk:"cluster_id"`
+	Timeout   types.String `tfsdk:"timeout"`
+}
+
+func NewClusterWaitIdleAction() action.Action {
+	return &clusterWaitIdleAction{}
+}
+
+func (a *clusterWaitIdleAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_wait_idle"
+}
+
+func (a *clusterWaitIdleAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Waits until the cluster has no request in progress, such as a resize or a datacenter addition.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.Int64Attribute{
+				Description: "Cluster ID",
+				Required:    true,
+			},
+			"timeout": schema.StringAttribute{
+				Description: "How long to wait, as a duration such as `30m`. Defaults to `60m`.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *clusterWaitIdleAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	var diags diag.Diagnostics
+	a.client, diags = providerdata.Client(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (a *clusterWaitIdleAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var m clusterWaitIdleModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &m)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if a.client == nil {
+		resp.Diagnostics.Append(providerdata.NotConfigured()...)
+		return
+	}
+
+	timeout, diags := actionTimeout(m.Timeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	clusterID := m.ClusterID.ValueInt64()
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for the requests in progress of cluster %d", clusterID),
+	})
+
+	if err := WaitForNoInProgressRequests(ctx, a.client, clusterID); err != nil {
+		resp.Diagnostics.AddError("Error waiting for cluster requests",
+			fmt.Sprintf("failed waiting for no in-progress cluster requests for cluster %d: %s", clusterID, err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Cluster %d has no requests in progress", clusterID),
+	})
+}
+
+// actionTimeout parses the optional timeout attribute of an action.
+func actionTimeout(v types.String) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.ValueString() == "" {
+		return defaultActionTimeout, nil
+	}
+
+	d, err := time.ParseDuration(v.ValueString())
+	if err != nil || d <= 0 {
+		diags.AddAttributeError(path.Root("timeout"), "Invalid timeout",
+			fmt.Sprintf("timeout must be a positive duration such as \"30m\", got %q", v.ValueString()))
+		return 0, diags
+	}
+
+	return d, nil
+}
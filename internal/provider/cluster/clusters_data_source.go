@@ -0,0 +1,286 @@
+package cluster
+
+import (
+	"cmp"
+	"context"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+)
+
+func DataSourceClusters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClustersRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Description:      "Regular expression the cluster name must match.",
+				Optional:         true,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validateRegexpDiag,
+			},
+			"cloud": {
+				Description: "Only return clusters of this cloud provider (AWS or GCP).",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"region": {
+				Description: "Only return clusters in this cloud region (e.g. us-east-1).",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"status": {
+				Description: "Only return clusters with this status (e.g. ACTIVE). Deleted clusters are " +
+					"skipped unless `status` is `DELETED`.",
+				Optional: true,
+				Type:     schema.TypeString,
+			},
+			"scylla_version": {
+				Description: "Only return clusters running this Scylla version (e.g. 2025.1.4).",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"user_api_interface": {
+				Description: "Only return clusters with this user API interface, CQL or ALTERNATOR.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"ids": {
+				Description: "The IDs of the matching clusters.",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"clusters": {
+				Description: "The matching clusters, ordered by ID.",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"cluster_id": {
+						Description: "The cluster ID.",
+						Computed:    true,
+						Type:        schema.TypeInt,
+					},
+					"name": {
+						Description: "The name of the cluster.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"cloud": {
+						Description: "The cloud provider of the cluster.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"region": {
+						Description: "The cloud region the cluster is deployed in.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"status": {
+						Description: "The cluster status.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"scylla_version": {
+						Description: "The Scylla version of the cluster.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"user_api_interface": {
+						Description: "The type of user API interface, CQL or ALTERNATOR.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"datacenter": {
+						Description: "The cluster datacenter name.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"cidr_block": {
+						Description: "The CIDR block of the cluster network.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"enable_dns": {
+						Description: "Whether DNS is enabled for the cluster.",
+						Computed:    true,
+						Type:        schema.TypeBool,
+					},
+					"enable_vpc_peering": {
+						Description: "Whether VPC peering is enabled for the cluster.",
+						Computed:    true,
+						Type:        schema.TypeBool,
+					},
+				}},
+			},
+		},
+	}
+}
+
+// clustersFilter selects clusters; empty fields match everything.
+type clustersFilter struct {
+	NameRegex        *regexp.Regexp
+	Cloud            string
+	Region           string
+	Status           string
+	ScyllaVersion    string
+	UserAPIInterface string
+}
+
+// clusterSummary holds the attributes of a listed cluster.
+type clusterSummary struct {
+	ID               int64
+	Name             string
+	Cloud            string
+	Region           string
+	Status           string
+	ScyllaVersion    string
+	UserAPIInterface string
+	Datacenter       string
+	CIDRBlock        string
+	DNS              bool
+	VPCPeering       bool
+}
+
+func (f *clustersFilter) match(s *clusterSummary) bool {
+	switch {
+	case f.NameRegex != nil && !f.NameRegex.MatchString(s.Name):
+		return false
+	case f.Cloud != "" && !strings.EqualFold(f.Cloud, s.Cloud):
+		return false
+	case f.Region != "" && !strings.EqualFold(f.Region, s.Region):
+		return false
+	case f.Status == "" && strings.EqualFold(s.Status, "DELETED"):
+		return false
+	case f.Status != "" && !strings.EqualFold(f.Status, s.Status):
+		return false
+	case f.ScyllaVersion != "" && f.ScyllaVersion != s.ScyllaVersion:
+		return false
+	case f.UserAPIInterface != "" && !strings.EqualFold(f.UserAPIInterface, s.UserAPIInterface):
+		return false
+	}
+	return true
+}
+
+// summarizeCluster extracts the listed attributes of the cluster. The cloud
+// provider and region names are looked up in the metadata when the list omits
+// them.
+func summarizeCluster(cluster *model.Cluster, meta *scylla.Cloudmeta) clusterSummary {
+	s := clusterSummary{
+		ID:               cluster.ID,
+		Name:             cluster.ClusterName,
+		Status:           cluster.Status,
+		UserAPIInterface: cluster.UserAPIInterface,
+		DNS:              cluster.DNS,
+		VPCPeering:       !strings.EqualFold(cluster.BroadcastType, "PUBLIC"),
+	}
+
+	if cluster.CloudProvider != nil {
+		s.Cloud = cluster.CloudProvider.Name
+	}
+	if s.Cloud == "" && meta != nil {
+		if p := meta.ProviderByID(cluster.CloudProviderID); p != nil {
+			s.Cloud = p.CloudProvider.Name
+		}
+	}
+	if cluster.Region != nil {
+		s.Region = cluster.Region.ExternalID
+	}
+	if cluster.ScyllaVersion != nil {
+		s.ScyllaVersion = cluster.ScyllaVersion.Version
+	}
+	if cluster.Datacenter != nil {
+		s.Datacenter = cluster.Datacenter.Name
+		s.CIDRBlock = cluster.Datacenter.CIDRBlock
+
+		if s.Region == "" && meta != nil {
+			if p := meta.ProviderByID(cluster.CloudProviderID); p != nil {
+				if r := p.RegionByID(cluster.Datacenter.RegionID); r != nil {
+					s.Region = r.ExternalID
+				}
+			}
+		}
+	}
+
+	return s
+}
+
+func dataSourceClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*scylla.Client)
+
+	f := clustersFilter{
+		Cloud:            d.Get("cloud").(string),
+		Region:           d.Get("region").(string),
+		Status:           d.Get("status").(string),
+		ScyllaVersion:    d.Get("scylla_version").(string),
+		UserAPIInterface: d.Get("user_api_interface").(string),
+	}
+	if expr := d.Get("name_regex").(string); expr != "" {
+		f.NameRegex = regexp.MustCompile(expr) // validated by the schema
+	}
+
+	clusters, err := c.ListClusters(ctx)
+	if err != nil {
+		return diag.Errorf("error reading cluster list: %s", err)
+	}
+
+	var (
+		ids     = make([]int64, 0, len(clusters))
+		matched = make([]map[string]interface{}, 0, len(clusters))
+	)
+
+	for _, s := range filterClusters(clusters, c.Meta, &f) {
+		ids = append(ids, s.ID)
+		matched = append(matched, map[string]interface{}{
+			"cluster_id":         s.ID,
+			"name":               s.Name,
+			"cloud":              s.Cloud,
+			"region":             s.Region,
+			"status":             s.Status,
+			"scylla_version":     s.ScyllaVersion,
+			"user_api_interface": s.UserAPIInterface,
+			"datacenter":         s.Datacenter,
+			"cidr_block":         s.CIDRBlock,
+			"enable_dns":         s.DNS,
+			"enable_vpc_peering": s.VPCPeering,
+		})
+	}
+
+	d.SetId(strconv.FormatInt(c.AccountID, 10))
+	_ = d.Set("ids", ids)
+	_ = d.Set("clusters", matched)
+
+	return nil
+}
+
+// filterClusters returns the summaries of the clusters matching f, ordered
+// by cluster ID.
+func filterClusters(clusters []model.Cluster, meta *scylla.Cloudmeta, f *clustersFilter) []clusterSummary {
+	var matched []clusterSummary
+	for i := range clusters {
+		if s := summarizeCluster(&clusters[i], meta); f.match(&s) {
+			matched = append(matched, s)
+		}
+	}
+
+	slices.SortFunc(matched, func(lhs, rhs clusterSummary) int {
+		return cmp.Compare(lhs.ID, rhs.ID)
+	})
+
+	return matched
+}
+
+func validateRegexpDiag(v interface{}, _ cty.Path) diag.Diagnostics {
+	if _, err := regexp.Compile(v.(string)); err != nil {
+		return diag.Errorf("invalid regular expression: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package cluster
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+)
+
+func TestFilterClusters(t *testing.T) {
+	t.Parallel()
+
+	meta := &scylla.Cloudmeta{
+		CloudProviders: []scylla.CloudProvider{{
+			CloudProvider: &model.CloudProvider{ID: 1, Name: "AWS"},
+			CloudProviderRegions: &model.CloudProviderRegions{
+				Regions: []model.CloudProviderRegion{{ID: 10, ExternalID: "us-east-1"}},
+			},
+		}, {
+			CloudProvider:        &model.CloudProvider{ID: 2, Name: "GCP"},
+			CloudProviderRegions: &model.CloudProviderRegions{},
+		}},
+	}
+
+	clusters := []model.Cluster{
+		{
+			ID: 3, ClusterName: "prod-eu", Status: "ACTIVE", CloudProviderID: 2, UserAPIInterface: "CQL",
+			Region:        &model.CloudProviderRegion{ExternalID: "europe-west1"},
+			ScyllaVersion: &model.ScyllaVersion{Version: "2025.1.4"},
+			Datacenter:    &model.Datacenter{Name: "GCP_EUROPE_WEST_1", CIDRBlock: "10.1.0.0/24"},
+		},
+		{
+			ID: 1, ClusterName: "prod-us", Status: "ACTIVE", CloudProviderID: 1, UserAPIInterface: "CQL",
+			ScyllaVersion: &model.ScyllaVersion{Version: "2025.1.4"},
+			Datacenter:    &model.Datacenter{Name: "AWS_US_EAST_1", CIDRBlock: "10.0.0.0/24", RegionID: 10},
+		},
+		{
+			ID: 2, ClusterName: "dynamo", Status: "ACTIVE", CloudProviderID: 1, UserAPIInterface: "ALTERNATOR",
+			BroadcastType: "PUBLIC",
+			ScyllaVersion: &model.ScyllaVersion{Version: "2024.2.0"},
+		},
+		{ID: 4, ClusterName: "prod-old", Status: "DELETED", CloudProviderID: 1},
+	}
+
+	ids := func(f clustersFilter) []int64 {
+		var ids []int64
+		for _, s := range filterClusters(clusters, meta, &f) {
+			ids = append(ids, s.ID)
+		}
+		return ids
+	}
+
+	require.Equal(t, []int64{1, 2, 3}, ids(clustersFilter{}))
+	require.Equal(t, []int64{1, 3}, ids(clustersFilter{NameRegex: regexp.MustCompile(`^prod-`)}))
+	require.Equal(t, []int64{1, 2}, ids(clustersFilter{Cloud: "aws"}))
+	require.Equal(t, []int64{1}, ids(clustersFilter{Region: "us-east-1"}))
+	require.Equal(t, []int64{4}, ids(clustersFilter{Status: "DELETED"}))
+	require.Equal(t, []int64{2}, ids(clustersFilter{ScyllaVersion: "2024.2.0"}))
+	require.Equal(t, []int64{2}, ids(clustersFilter{UserAPIInterface: "alternator"}))
+	require.Empty(t, ids(clustersFilter{Cloud: "GCP", Region: "us-east-1"}))
+
+	summaries := filterClusters(clusters, meta, &clustersFilter{Region: "us-east-1"})
+	require.Equal(t, []clusterSummary{{
+		ID:               1,
+		Name:             "prod-us",
+		Cloud:            "AWS",
+		Region:           "us-east-1",
+		Status:           "ACTIVE",
+		ScyllaVersion:    "2025.1.4",
+		UserAPIInterface: "CQL",
+		Datacenter:       "AWS_US_EAST_1",
+		CIDRBlock:        "10.0.0.0/24",
+		VPCPeering:       true,
+	}}, summaries)
+}
+
+func TestDataSourceClustersSchema(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, DataSourceClusters().InternalValidate(nil, false))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/cluster"
 	"github.com/scylladb/terraform-provider-scylladbcloud/internal/schemautils"
 	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
 	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
@@ -25,7 +27,7 @@ const (
 )
 
 func ResourceClusterConnection() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: resourceClusterConnectionCreate,
 		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 			err := resourceClusterConnectionRead(ctx, data, i)
@@ -42,12 +44,23 @@ func ResourceClusterConnection() *schema.Resource {
 		DeleteContext: resourceClusterConnectionDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
-				err := resourceClusterConnectionRead(ctx, data, i)
-				if err != nil {
-					return nil, err
+			StateContext: resourceClusterConnectionImport,
+		},
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"cluster_id": {
+						Description:       "Cluster ID",
+						Type:              schema.TypeInt,
+						RequiredForImport: true,
+					},
+					"connection_id": {
+						Description:       "Cluster connection ID",
+						Type:              schema.TypeInt,
+						RequiredForImport: true,
+					},
 				}
-				return []*schema.ResourceData{data}, nil
 			},
 		},
 
@@ -57,6 +70,8 @@ func ResourceClusterConnection() *schema.Resource {
 			Delete: schema.DefaultTimeout(clusterConnectionDeleteTimeout),
 		},
 
+		CustomizeDiff: resourceClusterConnectionCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Description: "Cluster connection ID",
@@ -88,15 +103,18 @@ func ResourceClusterConnection() *schema.Resource {
 				},
 			},
 			"type": {
-				Description: "Connection Type",
-				Required:    true,
+				Description: "Connection Type, e.g. `AWS_TGW_ATTACHMENT`. Computed when a typed connection block is used instead.",
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 				Type:        schema.TypeString,
 			},
 			"status": {
-				Description: "Connection Status",
-				Optional:    true,
-				Default:     "ACTIVE",
+				Description: "Connection Status. While the connection awaits customer action (see `awaiting_for_client`), " +
+					"the provisioning status it reports is not planned as a change.",
+				Optional:         true,
+				Default:          "ACTIVE",
+				DiffSuppressFunc: suppressStatusAwaitingClient,
 				ValidateDiagFunc: func(i interface{}, s cty.Path) diag.Diagnostics {
 					status := i.(string)
 					if status != "ACTIVE" && status != "INACTIVE " {
@@ -111,10 +129,35 @@ func ResourceClusterConnection() *schema.Resource {
 				Computed:    true,
 				Type:        schema.TypeString,
 			},
-			"data": {
-				Description: "Connection Data",
-				Required:    true,
+			"stage": {
+				Description: "Provisioning stage of the connection",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"stage_message": {
+				Description: "Human-readable description of the provisioning stage",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"awaiting_for_client": {
+				Description: "Whether provisioning waits for an action on the customer side, e.g. accepting the transit gateway attachment",
+				Computed:    true,
+				Type:        schema.TypeBool,
+			},
+			"awaiting_data": {
+				Description: "Details of the action provisioning waits for, e.g. the ID of the attachment to accept",
+				Computed:    true,
 				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"data": {
+				Description: "Connection Data, e.g. `tgwid` and `ramarn` for `AWS_TGW_ATTACHMENT`. Required with `type`; " +
+					"when a typed connection block is used instead, it is set from the block.",
+				Optional: true,
+				Computed: true,
+				Type:     schema.TypeMap,
 				ValidateDiagFunc: func(i interface{}, s cty.Path) diag.Diagnostics {
 					nonLowerCasedKeys := make([]string, 0)
 					for k := range i.(map[string]interface{}) {
@@ -134,6 +177,72 @@ func ResourceClusterConnection() *schema.Resource {
 			},
 		},
 	}
+
+	for i := range connectionBlocks {
+		r.Schema[connectionBlocks[i].Name] = connectionBlocks[i].schema()
+	}
+
+	return r
+}
+
+// resourceClusterConnectionCustomizeDiff checks how the connection type is
+// configured, plans "data" of typed connection blocks and rejects a cidrlist
+// that overlaps itself or the networks already routed through the cluster:
+// its datacenter networks, its VPC peerings and its other connections.
+func resourceClusterConnectionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateConnectionType(d); err != nil {
+		return err
+	}
+
+	if err := planConnectionData(d); err != nil {
+		return err
+	}
+
+	if d.Id() != "" && !d.HasChange("cidrlist") {
+		return nil
+	}
+
+	if raw := d.GetRawConfig(); raw.IsNull() || !d.NewValueKnown("cluster_id") || !raw.GetAttr("cidrlist").IsWhollyKnown() {
+		return nil
+	}
+
+	c, ok := meta.(*scylla.Client)
+	if !ok || c == nil {
+		return nil
+	}
+
+	cidrList, err := schemautils.ConvertListToConcrete[string](d.Get("cidrlist"))
+	if err != nil {
+		return err
+	}
+
+	requested := make([]schemautils.CIDRSource, 0, len(cidrList))
+	for _, cidr := range cidrList {
+		requested = append(requested, schemautils.CIDRSource{CIDR: cidr, Source: "cidrlist"})
+	}
+
+	clusterID := int64(d.Get("cluster_id").(int))
+	connID, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	existing, err := schemautils.ClusterCIDRSources(ctx, c, clusterID, "", connID)
+	if err != nil {
+		return err
+	}
+
+	overlaps, err := schemautils.FindCIDROverlaps(requested, existing)
+	if err != nil {
+		return err
+	}
+
+	return schemautils.CIDROverlapError(overlaps)
+}
+
+// suppressStatusAwaitingClient hides the difference between the configured
+// status and the provisioning status of a connection that awaits customer
+// action. Create leaves such a connection in state, and the update API cannot
+// move it forward; Read picks up the final status once the action is done.
+func suppressStatusAwaitingClient(_, old, _ string, d *schema.ResourceData) bool {
+	return d.Get("awaiting_for_client").(bool) && old != "ACTIVE" && old != "INACTIVE"
 }
 
 func resourceClusterConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -170,6 +279,10 @@ func resourceClusterConnectionCreate(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("unable to find %q datacenter", dcName)
 	}
 
+	if connType, data, ok := expandConnectionBlock(d); ok {
+		r.Type, r.Data = connType, data
+	}
+
 	if !cidrListOK {
 		return diag.Errorf(`"cidrlist" is required for %q cloud`, p.CloudProvider.Name)
 	}
@@ -188,17 +301,23 @@ func resourceClusterConnectionCreate(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("error creating cluster connection: %s", err)
 	}
 	d.SetId(strconv.FormatInt(conn.ID, 10))
-	err = waitForClusterConnection(ctx, c, int64(clusterID), conn.ID, "ACTIVE")
-	if err != nil {
-		return diag.Errorf("%+v", err)
+
+	if err := setClusterConnectionIdentity(d, int64(clusterID), conn.ID); err != nil {
+		return diag.FromErr(err)
 	}
-	conn, err = c.GetClusterConnection(ctx, int64(clusterID), conn.ID)
-	if err != nil {
-		return diag.Errorf("error reading cluster connection %d: %s", conn.ID, err)
+
+	conn, diags := waitForClusterConnectionDiag(ctx, c, int64(clusterID), conn.ID, "ACTIVE")
+	if diags.HasError() {
+		return diags
 	}
+
 	_ = d.Set("external_id", conn.ExternalID)
 	_ = d.Set("status", conn.Status)
-	return nil
+	_ = d.Set("type", r.Type)
+	_ = d.Set("data", r.Data)
+	setConnectionBlocks(d, r.Type, r.Data)
+	setClusterConnectionStage(d, conn)
+	return diags
 }
 
 var errNotFound = errors.New("not found")
@@ -221,20 +340,22 @@ func resourceClusterConnectionRead(ctx context.Context, d *schema.ResourceData,
 
 	clusterID := int64(d.Get("cluster_id").(int))
 
-	cluster, connection, err := findConnection(ctx, c, clusterID, connectionID)
+	clusterID, connection, err := findConnection(ctx, c, clusterID, connectionID)
 	if err != nil {
 		return err
 	}
-	if connection == nil {
-		return errors.Join(errNotFound, errors.New("error reading cluster connection"))
-	}
-	if cluster == nil {
-		return errors.Join(errNotFound, errors.New("error reading cluster"))
+
+	dcs, err := c.ListDataCenters(ctx, clusterID)
+	if err != nil {
+		if scylla.IsNotFound(err) || scylla.IsClusterDeletedErr(err) {
+			return errors.Join(errNotFound, errors.New("error reading cluster"))
+		}
+		return fmt.Errorf("error reading datacenters of cluster %d: %w", clusterID, err)
 	}
 
-	for id := range cluster.Datacenters {
-		if cluster.Datacenters[id].ID == connection.ClusterDCID {
-			dc = &cluster.Datacenters[id]
+	for i := range dcs {
+		if dcs[i].ID == connection.ClusterDCID {
+			dc = &dcs[i]
 			break
 		}
 	}
@@ -245,14 +366,114 @@ func resourceClusterConnectionRead(ctx context.Context, d *schema.ResourceData,
 
 	_ = d.Set("datacenter", dc.Name)
 	_ = d.Set("external_id", connection.ExternalID)
-	_ = d.Set("cluster_id", connection.ClusterID)
+	_ = d.Set("cluster_id", clusterID)
 	_ = d.Set("cidrlist", connection.CIDRList)
 	_ = d.Set("name", connection.Name)
 	_ = d.Set("data", schemautils.LowerCaseMapKeys(schemautils.ConvertMapFromConcrete(connection.Data)))
 	_ = d.Set("type", connection.Type)
 	_ = d.Set("status", connection.Status)
+	setConnectionBlocks(d, connection.Type, connection.Data)
+	setClusterConnectionStage(d, connection)
 	d.SetId(strconv.FormatInt(connection.ID, 10))
-	return nil
+	return setClusterConnectionIdentity(d, clusterID, connection.ID)
+}
+
+// resourceClusterConnectionImport accepts "cluster_id/connection_id" and
+// "cluster_name/connection_name" as well as a bare connection ID, which is
+// looked up across all clusters of the account. Without an ID the connection
+// is imported by its identity.
+func resourceClusterConnectionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	var (
+		c                       = meta.(*scylla.Client)
+		clusterID, connectionID int64
+		err                     error
+	)
+
+	if d.Id() == "" {
+		identity, err := d.Identity()
+		if err != nil {
+			return nil, err
+		}
+		clusterID = int64(identity.Get("cluster_id").(int))
+		connectionID = int64(identity.Get("connection_id").(int))
+	} else if clusterID, connectionID, err = parseClusterConnectionImportID(ctx, c, d.Id()); err != nil {
+		return nil, err
+	}
+
+	_ = d.Set("cluster_id", clusterID)
+	d.SetId(strconv.FormatInt(connectionID, 10))
+
+	if err := resourceClusterConnectionRead(ctx, d, meta); err != nil {
+		if errors.Is(err, errNotFound) {
+			return nil, fmt.Errorf("cluster connection %q not found", d.Id())
+		}
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setClusterConnectionIdentity(d *schema.ResourceData, clusterID, connectionID int64) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+	if err := identity.Set("cluster_id", clusterID); err != nil {
+		return err
+	}
+	return identity.Set("connection_id", connectionID)
+}
+
+func parseClusterConnectionImportID(ctx context.Context, c *scylla.Client, id string) (clusterID, connectionID int64, err error) {
+	clusterRef, connRef, ok := strings.Cut(id, "/")
+	if !ok {
+		connectionID, err = strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf(`invalid import ID %q, expected "cluster_id/connection_id", "cluster_name/connection_name" or a connection ID`, id)
+		}
+		return 0, connectionID, nil
+	}
+
+	if clusterRef == "" || connRef == "" || strings.Contains(connRef, "/") {
+		return 0, 0, fmt.Errorf(`invalid import ID %q, expected "cluster_id/connection_id" or "cluster_name/connection_name"`, id)
+	}
+
+	if clusterID, err = strconv.ParseInt(clusterRef, 10, 64); err != nil {
+		if clusterID, err = cluster.FindClusterIDByName(ctx, c, clusterRef); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if connectionID, err = strconv.ParseInt(connRef, 10, 64); err != nil {
+		if connectionID, err = findConnectionIDByName(ctx, c, clusterID, connRef); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return clusterID, connectionID, nil
+}
+
+func findConnectionIDByName(ctx context.Context, c *scylla.Client, clusterID int64, name string) (int64, error) {
+	conns, err := c.ListClusterConnections(ctx, clusterID)
+	if err != nil {
+		return 0, fmt.Errorf("error reading cluster connections for cluster %d: %w", clusterID, err)
+	}
+
+	var ids []int64
+	for _, conn := range conns {
+		if conn.Name == name && !strings.EqualFold(conn.Status, "DELETED") {
+			ids = append(ids, conn.ID)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return 0, fmt.Errorf("cluster connection %q not found in cluster %d", name, clusterID)
+	case 1:
+		return ids[0], nil
+	default:
+		return 0, fmt.Errorf("cluster connection name %q is ambiguous in cluster %d, it matches connections %v; import by connection ID instead", name, clusterID, ids)
+	}
 }
 
 func resourceClusterConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -266,6 +487,11 @@ func resourceClusterConnectionUpdate(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("failed to parse connection id %q: %s", d.Id(), err)
 	}
 
+	if d.Get("awaiting_for_client").(bool) {
+		return diag.Errorf("cluster connection %d is awaiting customer action at stage %q; "+
+			"complete it and run terraform apply again before changing the connection", connID, d.Get("stage").(string))
+	}
+
 	cidrlist, err := schemautils.ConvertListToConcrete[string](cidrListVal)
 	if err != nil {
 		return diag.Errorf("error converting cidrlist: %s", err)
@@ -281,17 +507,16 @@ func resourceClusterConnectionUpdate(ctx context.Context, d *schema.ResourceData
 	if err != nil {
 		return diag.Errorf("error updating cluster connection: %s", err)
 	}
-	err = waitForClusterConnection(ctx, c, int64(clusterID), connID, req.Status)
-	if err != nil {
-		return diag.Errorf("%+v", err)
-	}
 
-	conn, err := c.GetClusterConnection(ctx, int64(clusterID), connID)
-	if err != nil {
-		return diag.Errorf("error reading cluster connection %d: %s", connID, err)
+	conn, diags := waitForClusterConnectionDiag(ctx, c, int64(clusterID), connID, req.Status)
+	if diags.HasError() {
+		return diags
 	}
+
 	_ = d.Set("external_id", conn.ExternalID)
-	return nil
+	_ = d.Set("status", conn.Status)
+	setClusterConnectionStage(d, conn)
+	return diags
 }
 
 func resourceClusterConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -323,6 +548,84 @@ func resourceClusterConnectionDelete(ctx context.Context, d *schema.ResourceData
 	return nil
 }
 
+// awaitingClientError is returned by waitForClusterConnection when the
+// connection cannot make progress until the customer acts on it.
+type awaitingClientError struct {
+	conn *model.ClusterConnection
+}
+
+func (e *awaitingClientError) Error() string {
+	return fmt.Sprintf("cluster connection %d is awaiting customer action at stage %q", e.conn.ID, e.conn.Stage)
+}
+
+// diagnostic describes the awaited action, listing the data the backend
+// reported for it.
+func (e *awaitingClientError) diagnostic() diag.Diagnostic {
+	var b strings.Builder
+
+	if e.conn.StageMessage != "" {
+		fmt.Fprintf(&b, "%s\n\n", e.conn.StageMessage)
+	}
+
+	fmt.Fprintf(&b, "The connection is in status %q at stage %q and waits for an action on your side", e.conn.Status, e.conn.Stage)
+	if strings.EqualFold(e.conn.Type, "AWS_TGW_ATTACHMENT") {
+		b.WriteString(", such as accepting the transit gateway VPC attachment in your AWS account")
+	}
+	b.WriteString(".")
+
+	if len(e.conn.AwaitingData) != 0 {
+		keys := make([]string, 0, len(e.conn.AwaitingData))
+		for k := range e.conn.AwaitingData {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		b.WriteString(" Awaited data:\n")
+		for _, k := range keys {
+			fmt.Fprintf(&b, "\n  %s = %s", k, e.conn.AwaitingData[k])
+		}
+	}
+
+	b.WriteString("\n\nRun terraform apply again once the action is complete; the awaiting_for_client and awaiting_data attributes show the current state.")
+
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  e.Error(),
+		Detail:   b.String(),
+	}
+}
+
+// waitForClusterConnectionDiag waits for the connection to reach targetStatus
+// and returns its latest state. A connection awaiting customer action is
+// reported as a warning rather than an error, so that the created connection
+// is not tainted and gets picked up again on the next apply.
+func waitForClusterConnectionDiag(ctx context.Context, c *scylla.Client, clusterID, connectionID int64, targetStatus string) (*model.ClusterConnection, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var awaiting *awaitingClientError
+	err := waitForClusterConnection(ctx, c, clusterID, connectionID, targetStatus)
+	switch {
+	case errors.As(err, &awaiting):
+		diags = append(diags, awaiting.diagnostic())
+	case err != nil:
+		return nil, diag.Errorf("%+v", err)
+	}
+
+	conn, err := c.GetClusterConnection(ctx, clusterID, connectionID)
+	if err != nil {
+		return nil, append(diags, diag.Errorf("error reading cluster connection %d: %s", connectionID, err)...)
+	}
+
+	return conn, diags
+}
+
+func setClusterConnectionStage(d *schema.ResourceData, conn *model.ClusterConnection) {
+	_ = d.Set("stage", conn.Stage)
+	_ = d.Set("stage_message", conn.StageMessage)
+	_ = d.Set("awaiting_for_client", conn.AwaitingForClient)
+	_ = d.Set("awaiting_data", conn.AwaitingData)
+}
+
 func waitForClusterConnection(ctx context.Context, c *scylla.Client, clusterID, connectionID int64, targetStatus string) error {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{"PENDING", "INIT", "DELETING"},
@@ -330,6 +633,8 @@ func waitForClusterConnection(ctx context.Context, c *scylla.Client, clusterID,
 		Refresh: func() (interface{}, string, error) {
 			conn, err := c.GetClusterConnection(context.Background(), clusterID, connectionID)
 			switch {
+			case err == nil && conn.AwaitingForClient && conn.Status != targetStatus && targetStatus != "DELETED":
+				return conn, conn.Status, &awaitingClientError{conn: conn}
 			case err == nil:
 				return 0, conn.Status, nil
 			case scylla.IsNotFound(err), scylla.IsClusterConnectionDeletedErr(err):
@@ -344,50 +649,39 @@ func waitForClusterConnection(ctx context.Context, c *scylla.Client, clusterID,
 
 	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf("error waiting for cluster connection to become %q: %s", targetStatus, err)
+		return fmt.Errorf("error waiting for cluster connection to become %q: %w", targetStatus, err)
 	}
 	return nil
 }
 
-func findConnection(ctx context.Context, c *scylla.Client, clusterID, connectionID int64) (cluster *model.Cluster, connection *model.ClusterConnection, err error) {
+// findConnection reads the connection, looking it up across all clusters of
+// the account when clusterID is 0. It returns the ID of the owning cluster.
+func findConnection(ctx context.Context, c *scylla.Client, clusterID, connectionID int64) (int64, *model.ClusterConnection, error) {
 	if clusterID != 0 {
-		cluster, err = c.GetCluster(ctx, clusterID)
-		if err != nil {
-			if scylla.IsNotFound(err) {
-				return nil, nil, nil
-			}
-			return nil, nil, fmt.Errorf("error reading cluster %d: %s", clusterID, err)
-		}
-
-		connection, err = c.GetClusterConnection(ctx, cluster.ID, connectionID)
+		connection, err := c.GetClusterConnection(ctx, clusterID, connectionID)
 		switch {
 		case err == nil:
-			return cluster, connection, nil
-		case scylla.IsNotFound(err) || scylla.IsClusterConnectionDeletedErr(err):
-			return nil, nil, errNotFound
+			return clusterID, connection, nil
+		case scylla.IsNotFound(err) || scylla.IsClusterConnectionDeletedErr(err) || scylla.IsClusterDeletedErr(err):
+			return 0, nil, errNotFound
 		default:
-			return nil, nil, fmt.Errorf("error reading cluster connection %d: %s", connectionID, err)
+			return 0, nil, fmt.Errorf("error reading cluster connection %d: %s", connectionID, err)
 		}
 	}
+
 	clusters, err := c.ListClusters(ctx)
 	if err != nil {
-		return nil, nil, fmt.Errorf("error reading cluster list: %s", err)
+		return 0, nil, fmt.Errorf("error reading cluster list: %s", err)
 	}
 
 	for i := range clusters {
-		cluster = &clusters[i]
-		connection, err = c.GetClusterConnection(ctx, cluster.ID, connectionID)
+		connection, err := c.GetClusterConnection(ctx, clusters[i].ID, connectionID)
 		if err == nil {
-			// Repopulate cluster with detailed information
-			cluster, err = c.GetCluster(ctx, cluster.ID)
-			if err != nil {
-				return nil, nil, fmt.Errorf("error reading cluster %d: %s", clusterID, err)
-			}
-			return cluster, connection, nil
+			return clusters[i].ID, connection, nil
 		}
 		if !scylla.IsNotFound(err) && !scylla.IsClusterConnectionDeletedErr(err) {
-			return nil, nil, fmt.Errorf("error reading cluster connection %d: %s", connectionID, err)
+			return 0, nil, fmt.Errorf("error reading cluster connection %d: %s", connectionID, err)
 		}
 	}
-	return nil, nil, errNotFound
+	return 0, nil, errNotFound
 }
@@ -0,0 +1,171 @@
+package connection
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/cluster"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/providerdata"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/schemautils"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+)
+
+var (
+	_ list.ListResourceWithConfigure    = (*clusterConnectionListResource)(nil)
+	_ list.ListResourceWithRawV5Schemas = (*clusterConnectionListResource)(nil)
+)
+
+// clusterConnectionListResource lists the connections of one or all clusters
+// of the account for "terraform query".
+type clusterConnectionListResource struct {
+	client *scylla.Client
+}
+
+type clusterConnectionListModel struct {
+	ClusterID types.Int64 `tfsdk:"cluster_id"`
+}
+
+func NewClusterConnectionListResource() list.ListResource {
+	return &clusterConnectionListResource{}
+}
+
+func (r *clusterConnectionListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_connection"
+}
+
+func (r *clusterConnectionListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	res := ResourceClusterConnection()
+	resp.ProtoV5Schema = res.ProtoSchema(ctx)()
+	resp.ProtoV5IdentitySchema = res.ProtoIdentitySchema(ctx)()
+}
+
+func (r *clusterConnectionListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the connections of the clusters of the account.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.Int64Attribute{
+				Description: "Only list the connections of this cluster. Defaults to all clusters that are not deleted.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *clusterConnectionListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var diags diag.Diagnostics
+	r.client, diags = providerdata.Client(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *clusterConnectionListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var m clusterConnectionListModel
+
+	if diags := req.Config.Get(ctx, &m); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if r.client == nil {
+		stream.Results = list.ListResultsStreamDiagnostics(providerdata.NotConfigured())
+		return
+	}
+
+	clusterIDs := []int64{m.ClusterID.ValueInt64()}
+	if m.ClusterID.IsNull() {
+		var err error
+		if clusterIDs, err = cluster.ListClusterIDs(ctx, r.client); err != nil {
+			var diags diag.Diagnostics
+			diags.AddError("Error reading cluster list", err.Error())
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var n int64
+		for _, clusterID := range clusterIDs {
+			conns, err := r.client.ListClusterConnections(ctx, clusterID)
+			if err != nil {
+				var result list.ListResult
+				result.Diagnostics.AddError("Error reading cluster connections",
+					fmt.Sprintf("error reading cluster connections for cluster %d: %s", clusterID, err))
+				push(result)
+				return
+			}
+
+			// The resource objects carry the datacenter name, while the
+			// connections only report its ID.
+			var dcs []model.Datacenter
+			if req.IncludeResource {
+				if dcs, err = r.client.ListDataCenters(ctx, clusterID); err != nil {
+					var result list.ListResult
+					result.Diagnostics.AddError("Error reading cluster datacenters",
+						fmt.Sprintf("error reading datacenters of cluster %d: %s", clusterID, err))
+					push(result)
+					return
+				}
+			}
+
+			for i := range conns {
+				conn := &conns[i]
+
+				if strings.EqualFold(conn.Status, "DELETED") {
+					continue
+				}
+
+				if req.Limit > 0 && n >= req.Limit {
+					return
+				}
+				n++
+
+				if !push(clusterConnectionListResult(ctx, req, clusterID, conn, dcs)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func clusterConnectionListResult(ctx context.Context, req list.ListRequest, clusterID int64, conn *model.ClusterConnection, dcs []model.Datacenter) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = fmt.Sprintf("%s (cluster %d)", conn.Name, clusterID)
+
+	result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("cluster_id"), clusterID)...)
+	result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("connection_id"), conn.ID)...)
+
+	if req.IncludeResource {
+		attrs := map[string]any{
+			"id":          strconv.FormatInt(conn.ID, 10),
+			"cluster_id":  clusterID,
+			"name":        conn.Name,
+			"type":        conn.Type,
+			"cidrlist":    conn.CIDRList,
+			"external_id": conn.ExternalID,
+			"status":      conn.Status,
+			"data":        schemautils.LowerCaseMapKeys(conn.Data),
+		}
+
+		for i := range dcs {
+			if dcs[i].ID == conn.ClusterDCID {
+				attrs["datacenter"] = dcs[i].Name
+				break
+			}
+		}
+
+		for name, v := range attrs {
+			result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root(name), v)...)
+		}
+	}
+
+	return result
+}
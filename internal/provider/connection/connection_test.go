@@ -0,0 +1,166 @@
+package connection
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/scyllatest"
+)
+
+func TestAwaitingClientErrorDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	err := &awaitingClientError{conn: &model.ClusterConnection{
+		ID:                12,
+		Type:              "AWS_TGW_ATTACHMENT",
+		Status:            "PENDING",
+		Stage:             "ACCEPT_ATTACHMENT",
+		StageMessage:      "Waiting for the attachment to be accepted",
+		AwaitingForClient: true,
+		AwaitingData: map[string]string{
+			"tgwAttachmentId": "tgw-attach-0123456789abcdef0",
+			"region":          "us-east-1",
+		},
+	}}
+
+	d := err.diagnostic()
+	require.Equal(t, diag.Warning, d.Severity)
+	require.Equal(t, `cluster connection 12 is awaiting customer action at stage "ACCEPT_ATTACHMENT"`, d.Summary)
+	require.Contains(t, d.Detail, "Waiting for the attachment to be accepted")
+	require.Contains(t, d.Detail, "accepting the transit gateway VPC attachment")
+	require.Contains(t, d.Detail, "\n  region = us-east-1\n  tgwAttachmentId = tgw-attach-0123456789abcdef0")
+}
+
+func TestParseClusterConnectionImportID(t *testing.T) {
+	t.Parallel()
+
+	client := scyllatest.NewClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/account/7/clusters":
+			_, _ = w.Write([]byte(`{"data":{"clusters":[
+				{"id":1,"clusterName":"prod","status":"ACTIVE"},
+				{"id":2,"clusterName":"dup","status":"ACTIVE"},
+				{"id":3,"clusterName":"dup","status":"ACTIVE"},
+				{"id":4,"clusterName":"prod","status":"DELETED"}
+			]}}`))
+		case "/account/7/cluster/1/network/vpc/connection":
+			_, _ = w.Write([]byte(`{"data":{"connections":[
+				{"id":10,"name":"tgw","status":"ACTIVE"},
+				{"id":11,"name":"old","status":"DELETED"}
+			]}}`))
+		default:
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+	})
+
+	tests := []struct {
+		id                    string
+		clusterID, connection int64
+		wantErr               string
+	}{
+		{id: "123", connection: 123},
+		{id: "5/123", clusterID: 5, connection: 123},
+		{id: "prod/10", clusterID: 1, connection: 10},
+		{id: "prod/tgw", clusterID: 1, connection: 10},
+		{id: "1/tgw", clusterID: 1, connection: 10},
+		{id: "1/old", wantErr: `cluster connection "old" not found in cluster 1`},
+		{id: "staging/tgw", wantErr: `cluster "staging" not found`},
+		{id: "dup/tgw", wantErr: `cluster name "dup" is ambiguous`},
+		{id: "prod/", wantErr: "invalid import ID"},
+		{id: "a/b/c", wantErr: "invalid import ID"},
+		{id: "tgw", wantErr: "invalid import ID"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			t.Parallel()
+
+			clusterID, connectionID, err := parseClusterConnectionImportID(context.Background(), client, tt.id)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.clusterID, clusterID)
+			require.Equal(t, tt.connection, connectionID)
+		})
+	}
+}
+
+func TestResourceClusterConnectionAwaitingClientStatus(t *testing.T) {
+	t.Parallel()
+
+	state := func(awaiting bool) *terraform.InstanceState {
+		return &terraform.InstanceState{ID: "30", Attributes: map[string]string{
+			"id":                    "30",
+			"cluster_id":            "42",
+			"datacenter":            "AWS_US_EAST_1",
+			"cidrlist.#":            "1",
+			"cidrlist.0":            "10.5.0.0/16",
+			"type":                  "AWS_TGW_ATTACHMENT",
+			"status":                "PENDING",
+			"stage":                 "ACCEPT_ATTACHMENT",
+			"awaiting_for_client":   strconv.FormatBool(awaiting),
+			"data.%":                "2",
+			"data.tgwid":            "tgw-0123456789abcdef0",
+			"data.ramarn":           "arn:aws:ram:us-east-1:123456789012:resource-share/abc",
+			"awaiting_data.%":       "0",
+			"aws_transit_gateway.#": "0",
+		}}
+	}
+
+	r := ResourceClusterConnection()
+
+	typ := r.CoreConfigSchema().ImpliedType()
+	vals := make(map[string]cty.Value, len(typ.AttributeTypes()))
+	for name, attrType := range typ.AttributeTypes() {
+		vals[name] = cty.NullVal(attrType)
+	}
+	vals["cluster_id"] = cty.NumberIntVal(42)
+	vals["datacenter"] = cty.StringVal("AWS_US_EAST_1")
+	vals["cidrlist"] = cty.ListVal([]cty.Value{cty.StringVal("10.5.0.0/16")})
+	vals["type"] = cty.StringVal("AWS_TGW_ATTACHMENT")
+	vals["data"] = cty.MapVal(map[string]cty.Value{
+		"tgwid":  cty.StringVal("tgw-0123456789abcdef0"),
+		"ramarn": cty.StringVal("arn:aws:ram:us-east-1:123456789012:resource-share/abc"),
+	})
+	rawConfig := cty.ObjectVal(vals)
+	config := terraform.NewResourceConfigShimmed(rawConfig, r.CoreConfigSchema())
+
+	// The default ACTIVE status is not planned while the customer has to act.
+	s := state(true)
+	s.RawConfig = rawConfig
+	diff, err := r.Diff(t.Context(), s, config, nil)
+	require.NoError(t, err)
+	require.True(t, diff.Empty(), "%v", diff)
+
+	// Once the connection no longer waits, a PENDING status is a change.
+	s = state(false)
+	s.RawConfig = rawConfig
+	diff, err = r.Diff(t.Context(), s, config, nil)
+	require.NoError(t, err)
+	require.Equal(t, "ACTIVE", diff.Attributes["status"].New)
+
+	// Update does not call the API for a connection awaiting the customer.
+	c := scyllatest.NewClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+	d := r.TestResourceData()
+	d.SetId("30")
+	require.NoError(t, d.Set("cluster_id", 42))
+	require.NoError(t, d.Set("cidrlist", []string{"10.6.0.0/16"}))
+	require.NoError(t, d.Set("awaiting_for_client", true))
+	require.NoError(t, d.Set("stage", "ACCEPT_ATTACHMENT"))
+	diags := resourceClusterConnectionUpdate(t.Context(), d, c)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, `awaiting customer action at stage "ACCEPT_ATTACHMENT"`)
+}
@@ -0,0 +1,230 @@
+package connection
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/schemautils"
+)
+
+// connectionBlockField maps an attribute of a typed connection block onto a
+// key of the connection "data" map.
+type connectionBlockField struct {
+	Attribute   string
+	DataKey     string
+	Description string
+	Pattern     *regexp.Regexp
+	Example     string
+}
+
+// connectionBlock describes a typed alternative to the free-form "type" and
+// "data" arguments for one connection type.
+type connectionBlock struct {
+	Name        string
+	Type        string
+	Description string
+	Fields      []connectionBlockField
+}
+
+// connectionBlocks lists the connection types with a typed block. Other types,
+// such as private endpoints, have no typed block as their type names and data
+// keys are not known here; they are created through the raw "type" and "data"
+// arguments, see the resource documentation.
+var connectionBlocks = []connectionBlock{{
+	Name:        "aws_transit_gateway",
+	Type:        "AWS_TGW_ATTACHMENT",
+	Description: "AWS Transit Gateway VPC attachment. Conflicts with `type` and `data`.",
+	Fields: []connectionBlockField{{
+		Attribute:   "tgw_id",
+		DataKey:     "tgwid",
+		Description: "ID of the transit gateway, e.g. `tgw-08461afa1119f390a`.",
+		Pattern:     regexp.MustCompile(`^tgw-[0-9a-f]{8,17}$`),
+		Example:     "tgw-08461afa1119f390a",
+	}, {
+		Attribute:   "ram_arn",
+		DataKey:     "ramarn",
+		Description: "ARN of the AWS RAM resource share the transit gateway is shared through.",
+		Pattern:     regexp.MustCompile(`^arn:aws(-[a-z]+)*:ram:[a-z0-9-]+:[0-9]{12}:resource-share/[0-9a-f-]+$`),
+		Example:     "arn:aws:ram:us-east-1:123456789012:resource-share/be3b0395-1782-47cb-9ae4-6d3517c6a721",
+	}},
+}}
+
+// connectionBlockNames returns the names of all typed connection blocks.
+func connectionBlockNames() []string {
+	names := make([]string, 0, len(connectionBlocks))
+	for _, b := range connectionBlocks {
+		names = append(names, b.Name)
+	}
+	return names
+}
+
+func (b *connectionBlock) schema() *schema.Schema {
+	fields := make(map[string]*schema.Schema, len(b.Fields))
+	for _, f := range b.Fields {
+		fields[f.Attribute] = &schema.Schema{
+			Description:      f.Description,
+			Required:         true,
+			ForceNew:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: f.validate,
+		}
+	}
+
+	// Computed so that connections imported or configured through the raw
+	// "data" map do not plan a replacement.
+	return &schema.Schema{
+		Description:   b.Description,
+		Optional:      true,
+		Computed:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		Type:          schema.TypeList,
+		ConflictsWith: []string{"type", "data"},
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func (f *connectionBlockField) validate(v interface{}, _ cty.Path) diag.Diagnostics {
+	s, ok := v.(string)
+	if !ok {
+		return diag.Errorf("expected a string, got %T", v)
+	}
+	if !f.Pattern.MatchString(s) {
+		return diag.Errorf("invalid %q %q, expected a value such as %q", f.Attribute, s, f.Example)
+	}
+	return nil
+}
+
+// data converts the configured block into the connection "data" map.
+func (b *connectionBlock) data(block map[string]interface{}) map[string]string {
+	data := make(map[string]string, len(b.Fields))
+	for _, f := range b.Fields {
+		data[f.DataKey] = block[f.Attribute].(string)
+	}
+	return data
+}
+
+// flatten converts the connection "data" map into the block state, which is
+// empty if connType is not the block's type.
+func (b *connectionBlock) flatten(connType string, data map[string]string) []interface{} {
+	if !strings.EqualFold(connType, b.Type) {
+		return []interface{}{}
+	}
+
+	lower := make(map[string]string, len(data))
+	for k, v := range data {
+		lower[strings.ToLower(k)] = v
+	}
+
+	block := make(map[string]interface{}, len(b.Fields))
+	for _, f := range b.Fields {
+		block[f.Attribute] = lower[f.DataKey]
+	}
+	return []interface{}{block}
+}
+
+// expandConnectionBlock returns the type and data of the configured typed
+// connection block, if any.
+func expandConnectionBlock(d *schema.ResourceData) (connType string, data map[string]string, ok bool) {
+	for i := range connectionBlocks {
+		b := &connectionBlocks[i]
+		v := d.Get(b.Name).([]interface{})
+		if len(v) == 0 || v[0] == nil {
+			continue
+		}
+		return b.Type, b.data(v[0].(map[string]interface{})), true
+	}
+	return "", nil, false
+}
+
+// setConnectionBlocks populates the typed connection blocks from the
+// connection type and data.
+func setConnectionBlocks(d *schema.ResourceData, connType string, data map[string]string) {
+	for i := range connectionBlocks {
+		b := &connectionBlocks[i]
+		_ = d.Set(b.Name, b.flatten(connType, data))
+	}
+}
+
+// validateConnectionType checks that the connection type is set either
+// through "type" and "data" or through exactly one typed block.
+func validateConnectionType(d *schema.ResourceDiff) error {
+	raw := d.GetRawConfig()
+	if raw.IsNull() {
+		return nil
+	}
+
+	var blocks []string
+	for _, name := range connectionBlockNames() {
+		if v := raw.GetAttr(name); !v.IsKnown() || (!v.IsNull() && v.LengthInt() > 0) {
+			blocks = append(blocks, name)
+		}
+	}
+
+	typ := raw.GetAttr("type")
+	switch {
+	case len(blocks) > 1:
+		return fmt.Errorf("only one of %q can be set", blocks)
+	case len(blocks) == 1:
+		return nil
+	case typ.IsNull():
+		return fmt.Errorf(`one of "type" or %q must be set`, connectionBlockNames())
+	case raw.GetAttr("data").IsNull():
+		return fmt.Errorf(`"data" is required when "type" is set`)
+	}
+	return nil
+}
+
+// planConnectionData plans "data" from the typed connection block when the
+// configuration omits it. Otherwise "data", being computed, would keep its
+// prior value, e.g. the extra keys of a connection first configured through
+// the raw "data" map. The API cannot update the data of a connection, so a
+// difference replaces it.
+func planConnectionData(d *schema.ResourceDiff) error {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.GetAttr("data").IsNull() {
+		return nil
+	}
+
+	for i := range connectionBlocks {
+		b := &connectionBlocks[i]
+
+		v := raw.GetAttr(b.Name)
+		if !v.IsWhollyKnown() {
+			return d.SetNewComputed("data")
+		}
+		if v.IsNull() || v.LengthInt() == 0 {
+			continue
+		}
+
+		block := d.Get(b.Name).([]interface{})
+		if len(block) == 0 || block[0] == nil {
+			return nil
+		}
+
+		data := b.data(block[0].(map[string]interface{}))
+
+		old := schemautils.ConvertMapToConcrete[string](d.Get("data").(map[string]interface{}))
+		if maps.Equal(old, data) {
+			return nil
+		}
+
+		if err := d.SetNew("data", schemautils.ConvertMapFromConcrete(data)); err != nil {
+			return err
+		}
+		if d.Id() != "" {
+			return d.ForceNew("data")
+		}
+		return nil
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package connection
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceClusterConnectionSchema(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ResourceClusterConnection().InternalValidate(nil, true))
+}
+
+func TestConnectionBlockValidation(t *testing.T) {
+	t.Parallel()
+
+	tgw := connectionBlocks[0]
+	require.Equal(t, "aws_transit_gateway", tgw.Name)
+
+	fields := make(map[string]connectionBlockField)
+	for _, f := range tgw.Fields {
+		fields[f.Attribute] = f
+	}
+
+	tests := []struct {
+		name  string
+		field string
+		value string
+		valid bool
+	}{
+		{name: "tgw id", field: "tgw_id", value: "tgw-08461afa1119f390a", valid: true},
+		{name: "short tgw id", field: "tgw_id", value: "tgw-0846afa1", valid: true},
+		{name: "tgw attachment id", field: "tgw_id", value: "tgw-attach-08461afa1119f390a"},
+		{name: "uppercase tgw id", field: "tgw_id", value: "TGW-08461AFA1119F390A"},
+		{name: "ram arn", field: "ram_arn", value: "arn:aws:ram:us-east-1:043400831220:resource-share/be3b0395-1782-47cb-9ae4-6d3517c6a721", valid: true},
+		{name: "govcloud ram arn", field: "ram_arn", value: "arn:aws-us-gov:ram:us-gov-west-1:043400831220:resource-share/be3b0395-1782-47cb-9ae4-6d3517c6a721", valid: true},
+		{name: "ram arn of other service", field: "ram_arn", value: "arn:aws:ec2:us-east-1:043400831220:transit-gateway/tgw-08461afa1119f390a"},
+		{name: "ram arn short account", field: "ram_arn", value: "arn:aws:ram:us-east-1:0434:resource-share/be3b0395"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := fields[tt.field]
+			diags := f.validate(tt.value, cty.Path{})
+			if tt.valid {
+				require.Empty(t, diags)
+			} else {
+				require.NotEmpty(t, diags)
+			}
+		})
+	}
+}
+
+func TestConnectionBlockData(t *testing.T) {
+	t.Parallel()
+
+	tgw := connectionBlocks[0]
+	block := map[string]interface{}{
+		"tgw_id":  "tgw-08461afa1119f390a",
+		"ram_arn": "arn:aws:ram:us-east-1:043400831220:resource-share/be3b0395",
+	}
+
+	data := tgw.data(block)
+	require.Equal(t, map[string]string{
+		"tgwid":  "tgw-08461afa1119f390a",
+		"ramarn": "arn:aws:ram:us-east-1:043400831220:resource-share/be3b0395",
+	}, data)
+
+	require.Equal(t, []interface{}{block}, tgw.flatten("AWS_TGW_ATTACHMENT", map[string]string{
+		"tgwId":  "tgw-08461afa1119f390a",
+		"ramArn": "arn:aws:ram:us-east-1:043400831220:resource-share/be3b0395",
+	}))
+	require.Empty(t, tgw.flatten("OTHER", data))
+}
+
+func TestPlanConnectionData(t *testing.T) {
+	t.Parallel()
+
+	const (
+		tgwID  = "tgw-08461afa1119f390a"
+		ramARN = "arn:aws:ram:us-east-1:043400831220:resource-share/be3b0395"
+	)
+
+	state := func(data map[string]string) *terraform.InstanceState {
+		attrs := map[string]string{
+			"id":                            "30",
+			"cluster_id":                    "42",
+			"datacenter":                    "AWS_US_EAST_1",
+			"cidrlist.#":                    "1",
+			"cidrlist.0":                    "10.5.0.0/16",
+			"type":                          "AWS_TGW_ATTACHMENT",
+			"status":                        "ACTIVE",
+			"aws_transit_gateway.#":         "1",
+			"aws_transit_gateway.0.tgw_id":  tgwID,
+			"aws_transit_gateway.0.ram_arn": ramARN,
+			"data.%":                        strconv.Itoa(len(data)),
+			"awaiting_data.%":               "0",
+		}
+		for k, v := range data {
+			attrs["data."+k] = v
+		}
+		return &terraform.InstanceState{ID: "30", Attributes: attrs}
+	}
+
+	r := ResourceClusterConnection()
+
+	// CustomizeDiff inspects the raw configuration, which the gRPC server
+	// passes in the prior state.
+	typ := r.CoreConfigSchema().ImpliedType()
+	vals := make(map[string]cty.Value, len(typ.AttributeTypes()))
+	for name, attrType := range typ.AttributeTypes() {
+		vals[name] = cty.NullVal(attrType)
+	}
+	vals["cluster_id"] = cty.NumberIntVal(42)
+	vals["datacenter"] = cty.StringVal("AWS_US_EAST_1")
+	vals["cidrlist"] = cty.ListVal([]cty.Value{cty.StringVal("10.5.0.0/16")})
+	vals["aws_transit_gateway"] = cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+		"tgw_id":  cty.StringVal(tgwID),
+		"ram_arn": cty.StringVal(ramARN),
+	})})
+	rawConfig := cty.ObjectVal(vals)
+	config := terraform.NewResourceConfigShimmed(rawConfig, r.CoreConfigSchema())
+
+	// The data matches the block.
+	s := state(map[string]string{"tgwid": tgwID, "ramarn": ramARN})
+	s.RawConfig = rawConfig
+	diff, err := r.Diff(t.Context(), s, config, nil)
+	require.NoError(t, err)
+	require.True(t, diff.Empty(), "%v", diff)
+
+	// The data has a key the block does not set.
+	s = state(map[string]string{"tgwid": tgwID, "ramarn": ramARN, "extra": "x"})
+	s.RawConfig = rawConfig
+	diff, err = r.Diff(t.Context(), s, config, nil)
+	require.NoError(t, err)
+	require.True(t, diff.RequiresNew())
+	require.Equal(t, "", diff.Attributes["data.extra"].New)
+	require.True(t, diff.Attributes["data.extra"].NewRemoved)
+
+	// A new connection plans the data of the block.
+	diff, err = r.Diff(t.Context(), &terraform.InstanceState{RawConfig: rawConfig}, config, nil)
+	require.NoError(t, err)
+	require.Equal(t, tgwID, diff.Attributes["data.tgwid"].New)
+	require.Equal(t, ramARN, diff.Attributes["data.ramarn"].New)
+}
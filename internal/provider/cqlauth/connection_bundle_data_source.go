@@ -0,0 +1,229 @@
+package cqlauth
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"sigs.k8s.io/yaml"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+)
+
+// Address types of the datacenter seeds.
+const (
+	AddressDNS     = "DNS"
+	AddressPublic  = "PUBLIC_IP"
+	AddressPrivate = "PRIVATE_IP"
+)
+
+const bundleAuthInfoName = "default"
+
+func DataSourceConnectionBundle() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceConnectionBundleRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Description: "Cluster ID",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ValidateFunc: func(i interface{}, s string) ([]string, []error) {
+					if i.(int) < 1 {
+						return nil, []error{fmt.Errorf("cluster_id must be greater than 0")}
+					}
+					return nil, nil
+				},
+			},
+			"datacenter": {
+				Description: "The datacenter of the current context of the bundle. Defaults to the first datacenter of the cluster.",
+				Optional:    true,
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"address_type": {
+				Description: "The addresses the bundle connects to: `DNS`, `PUBLIC_IP` or `PRIVATE_IP`. " +
+					"With TLS, IP connections verify the certificate against the DNS name of the node connected to.",
+				Optional: true,
+				Default:  AddressDNS,
+				Type:     schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					AddressDNS, AddressPublic, AddressPrivate,
+				}, false),
+			},
+			"tls_enabled": {
+				Description: "Whether the cluster has client-to-node encryption enabled. " +
+					"Without it the bundle carries no CA certificate and connects to the plain CQL port.",
+				Computed: true,
+				Type:     schema.TypeBool,
+			},
+			"connection_bundle": {
+				Description: "The connection bundle, a `CQLConnectionConfig` YAML document with one entry per datacenter.",
+				Computed:    true,
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func dataSourceConnectionBundleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		c         = meta.(*scylla.Client)
+		clusterID = int64(d.Get("cluster_id").(int))
+		dcName    = d.Get("datacenter").(string)
+		addrType  = d.Get("address_type").(string)
+	)
+
+	conn, err := c.Connect(ctx, clusterID)
+	if err != nil {
+		return diag.Errorf("error reading connection details: %s", err)
+	}
+
+	dc, err := getConnectionDCByName(conn, dcName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var caCert []byte
+	switch cert, err := c.GetClusterCertificate(ctx, clusterID); {
+	case scylla.IsEncryptionDisabledErr(err):
+	case err != nil:
+		return diag.Errorf("error reading cluster certificate: %s", err)
+	default:
+		caCert = []byte(cert.Content)
+	}
+
+	var dnsNames map[string]string
+	if len(caCert) != 0 && addrType != AddressDNS {
+		nodes, err := c.ListClusterNodes(ctx, clusterID)
+		if err != nil {
+			return diag.Errorf("error reading cluster nodes: %s", err)
+		}
+		dnsNames = nodeDNSNames(nodes)
+	}
+
+	bundle, err := buildConnectionBundle(conn, dc.Name, addrType, caCert, dnsNames)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	p, err := yaml.Marshal(bundle)
+	if err != nil {
+		return diag.Errorf("error rendering connection bundle: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s/%s", clusterID, dc.Name, addrType))
+	_ = d.Set("datacenter", dc.Name)
+	_ = d.Set("tls_enabled", len(caCert) != 0)
+	_ = d.Set("connection_bundle", string(p))
+
+	return nil
+}
+
+// buildConnectionBundle builds a connection bundle with an entry for every
+// datacenter of the cluster; the current context uses the currentDC one.
+// Each datacenter connects to its first seed. With TLS and IP addresses, it is
+// the first seed whose DNS name is known from dnsNames, keyed by address.
+func buildConnectionBundle(conn *model.ClusterConnectionInformation, currentDC, addrType string, caCert []byte, dnsNames map[string]string) (*model.CQLConnectionConfig, error) {
+	port := cqlPort
+	if len(caCert) != 0 {
+		port = cqlTLSPort
+	}
+
+	bundle := &model.CQLConnectionConfig{
+		Kind:        model.CQLConnectionConfigKind,
+		APIVersion:  model.CQLConnectionConfigAPIVersion,
+		Datacenters: make(map[string]*model.CQLConnectionDatacenter, len(conn.Datacenters)),
+		AuthInfos: map[string]*model.CQLConnectionAuthInfo{
+			bundleAuthInfoName: {
+				Username: conn.Credentials.Username,
+				Password: conn.Credentials.Password,
+			},
+		},
+		Contexts:       make(map[string]*model.CQLConnectionContext, len(conn.Datacenters)),
+		CurrentContext: currentDC,
+		Parameters: &model.CQLConnectionParameters{
+			DefaultConsistency:       "LOCAL_QUORUM",
+			DefaultSerialConsistency: "LOCAL_SERIAL",
+		},
+	}
+
+	for i := range conn.Datacenters {
+		dc := &conn.Datacenters[i]
+
+		addrs := DCAddresses(dc, addrType)
+		if len(addrs) == 0 {
+			return nil, fmt.Errorf("datacenter %q has no %s addresses", dc.Name, addrType)
+		}
+
+		server, serverName := addrs[0], ""
+		// Certificates are issued for the DNS names, so verify IP connections
+		// against the DNS name of the seed connected to.
+		if len(caCert) != 0 && addrType != AddressDNS {
+			i := slices.IndexFunc(addrs, func(addr string) bool { return dnsNames[addr] != "" })
+			if i == -1 {
+				return nil, fmt.Errorf("datacenter %q has no %s address with a known DNS name to verify TLS connections against; use the %s address type",
+					dc.Name, addrType, AddressDNS)
+			}
+			server, serverName = addrs[i], dnsNames[addrs[i]]
+		}
+
+		bundleDC := &model.CQLConnectionDatacenter{
+			CertificateAuthorityData: caCert,
+			Server:                   net.JoinHostPort(server, strconv.Itoa(port)),
+			TLSServerName:            serverName,
+		}
+
+		bundle.Datacenters[dc.Name] = bundleDC
+		bundle.Contexts[dc.Name] = &model.CQLConnectionContext{
+			DatacenterName: dc.Name,
+			AuthInfoName:   bundleAuthInfoName,
+		}
+	}
+
+	return bundle, nil
+}
+
+// nodeDNSNames maps the public and private addresses of the nodes to their DNS
+// names.
+func nodeDNSNames(nodes []model.Node) map[string]string {
+	names := make(map[string]string, 2*len(nodes))
+	for _, n := range nodes {
+		if n.DNS == "" {
+			continue
+		}
+		if n.PublicIP != "" {
+			names[n.PublicIP] = n.DNS
+		}
+		if n.PrivateIP != "" {
+			names[n.PrivateIP] = n.DNS
+		}
+	}
+	return names
+}
+
+// DCAddresses returns the seed addresses of dc of the given address type.
+func DCAddresses(dc *model.DatacenterConnection, addrType string) []string {
+	switch strings.ToUpper(addrType) {
+	case AddressDNS:
+		return dc.DNS
+	case AddressPublic:
+		return dc.PublicIP
+	case AddressPrivate:
+		return dc.PrivateIP
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package cqlauth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+)
+
+func TestBuildConnectionBundle(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, DataSourceConnectionBundle().InternalValidate(nil, false))
+
+	conn := &model.ClusterConnectionInformation{
+		Datacenters: []model.DatacenterConnection{
+			{
+				Name:      "AWS_US_EAST_1",
+				DNS:       []string{"node-0.example.com", "node-1.example.com"},
+				PublicIP:  []string{"3.3.3.4", "3.3.3.3"},
+				PrivateIP: []string{"10.0.0.1", "10.0.0.2"},
+			},
+			{Name: "AWS_EU_WEST_1", DNS: []string{"node-3.example.com"}, PublicIP: []string{"4.4.4.4"}},
+		},
+	}
+	conn.Credentials.Username = "scylla"
+	conn.Credentials.Password = "secret"
+
+	ca := []byte("-----BEGIN CERTIFICATE-----\n...\n-----END CERTIFICATE-----\n")
+
+	// The seeds are not listed in the same order as the DNS names, and the
+	// first public seed has no known DNS name.
+	dnsNames := nodeDNSNames([]model.Node{
+		{DNS: "node-0.example.com", PublicIP: "3.3.3.3", PrivateIP: "10.0.0.1"},
+		{DNS: "node-1.example.com", PrivateIP: "10.0.0.2"},
+		{DNS: "node-3.example.com", PublicIP: "4.4.4.4"},
+		{PublicIP: "3.3.3.4"},
+	})
+
+	bundle, err := buildConnectionBundle(conn, "AWS_EU_WEST_1", AddressPublic, ca, dnsNames)
+	require.NoError(t, err)
+
+	// Round-trip through YAML the way drivers read the bundle.
+	p, err := yaml.Marshal(bundle)
+	require.NoError(t, err)
+
+	var got model.CQLConnectionConfig
+	require.NoError(t, yaml.Unmarshal(p, &got))
+
+	require.Equal(t, model.CQLConnectionConfigKind, got.Kind)
+	require.Equal(t, model.CQLConnectionConfigAPIVersion, got.APIVersion)
+	require.Equal(t, "AWS_EU_WEST_1", got.CurrentContext)
+	require.Equal(t, &model.CQLConnectionContext{DatacenterName: "AWS_EU_WEST_1", AuthInfoName: "default"}, got.Contexts["AWS_EU_WEST_1"])
+	require.Equal(t, &model.CQLConnectionAuthInfo{Username: "scylla", Password: "secret"}, got.AuthInfos["default"])
+	require.Equal(t, &model.CQLConnectionDatacenter{
+		CertificateAuthorityData: ca,
+		Server:                   "3.3.3.3:9142",
+		TLSServerName:            "node-0.example.com",
+	}, got.Datacenters["AWS_US_EAST_1"])
+	require.Equal(t, "4.4.4.4:9142", got.Datacenters["AWS_EU_WEST_1"].Server)
+	require.Equal(t, "node-3.example.com", got.Datacenters["AWS_EU_WEST_1"].TLSServerName)
+
+	bundle, err = buildConnectionBundle(conn, "AWS_US_EAST_1", AddressPublic, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, &model.CQLConnectionDatacenter{Server: "3.3.3.4:9042"}, bundle.Datacenters["AWS_US_EAST_1"])
+
+	bundle, err = buildConnectionBundle(conn, "AWS_US_EAST_1", AddressDNS, ca, nil)
+	require.NoError(t, err)
+	require.Equal(t, &model.CQLConnectionDatacenter{
+		CertificateAuthorityData: ca,
+		Server:                   "node-0.example.com:9142",
+	}, bundle.Datacenters["AWS_US_EAST_1"])
+
+	_, err = buildConnectionBundle(conn, "AWS_US_EAST_1", AddressPublic, ca, nodeDNSNames(nil))
+	require.EqualError(t, err, `datacenter "AWS_US_EAST_1" has no PUBLIC_IP address with a known DNS name `+
+		`to verify TLS connections against; use the DNS address type`)
+
+	_, err = buildConnectionBundle(conn, "AWS_US_EAST_1", AddressPrivate, nil, nil)
+	require.EqualError(t, err, `datacenter "AWS_EU_WEST_1" has no PRIVATE_IP addresses`)
+}
@@ -65,6 +65,52 @@ func DataSourceCQLAuth() *schema.Resource {
 				Computed:    true,
 				Type:        schema.TypeString,
 			},
+			"seed_list": {
+				Description: "Seed node addresses",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"port": {
+				Description: "CQL port",
+				Computed:    true,
+				Type:        schema.TypeInt,
+			},
+			"tls_port": {
+				Description: "CQL port with client-to-node encryption (TLS)",
+				Computed:    true,
+				Type:        schema.TypeInt,
+			},
+			"datacenters": {
+				Description: "Connection details of all datacenters of the cluster",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "Datacenter name",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"dns": {
+						Description: "DNS names of the seed nodes",
+						Computed:    true,
+						Type:        schema.TypeList,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"public_ips": {
+						Description: "Public IP addresses of the seed nodes",
+						Computed:    true,
+						Type:        schema.TypeList,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"private_ips": {
+						Description: "Private IP addresses of the seed nodes",
+						Computed:    true,
+						Type:        schema.TypeList,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+				}},
+			},
 			"username": {
 				Description: "CQL username",
 				Computed:    true,
@@ -88,7 +134,7 @@ func dataSourceCQLAuthRead(ctx context.Context, d *schema.ResourceData, meta int
 		dns       = d.Get("dns").(bool)
 	)
 
-	cluster, err := c.GetCluster(ctx, clusterID)
+	clusterName, err := getClusterName(ctx, c, clusterID)
 	if err != nil {
 		return diag.Errorf("error reading cluster: %s", err)
 	}
@@ -108,16 +154,46 @@ func dataSourceCQLAuthRead(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.FromErr(err)
 	}
 
-	d.SetId(seeds)
-	_ = d.Set("cluster_name", cluster.ClusterName)
+	dcs := make([]map[string]interface{}, 0, len(conn.Datacenters))
+	for _, dc := range conn.Datacenters {
+		dcs = append(dcs, map[string]interface{}{
+			"name":        dc.Name,
+			"dns":         dc.DNS,
+			"public_ips":  dc.PublicIP,
+			"private_ips": dc.PrivateIP,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s", clusterID, dc.Name))
+	_ = d.Set("cluster_name", clusterName)
 	_ = d.Set("datacenter", dc.Name)
 	_ = d.Set("username", conn.Credentials.Username)
 	_ = d.Set("password", conn.Credentials.Password)
 	_ = d.Set("seeds", seeds)
+	_ = d.Set("seed_list", SplitSeeds(seeds))
+	_ = d.Set("port", cqlPort)
+	_ = d.Set("tls_port", cqlTLSPort)
+	_ = d.Set("datacenters", dcs)
 
 	return nil
 }
 
+// getClusterName looks the cluster up in the cluster list, which unlike
+// GetCluster supports clusters with several datacenters.
+func getClusterName(ctx context.Context, c *scylla.Client, clusterID int64) (string, error) {
+	clusters, err := c.ListClusters(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, cluster := range clusters {
+		if cluster.ID == clusterID {
+			return cluster.ClusterName, nil
+		}
+	}
+	return "", fmt.Errorf("cluster %d not found", clusterID)
+}
+
 func getSeeds(dns bool, dc *model.DatacenterConnection, conn *model.ClusterConnectionInformation) (string, error) {
 	if dns {
 		if len(dc.DNS) == 0 {
@@ -140,6 +216,18 @@ func getSeeds(dns bool, dc *model.DatacenterConnection, conn *model.ClusterConne
 	return strings.Join(dc.PublicIP, ","), nil
 }
 
+// SplitSeeds splits a comma-separated list of seed addresses, as returned in
+// the seeds attribute, trimming whitespace and dropping empty entries.
+func SplitSeeds(s string) []string {
+	seeds := make([]string, 0, strings.Count(s, ",")+1)
+	for _, seed := range strings.Split(s, ",") {
+		if seed = strings.TrimSpace(seed); seed != "" {
+			seeds = append(seeds, seed)
+		}
+	}
+	return seeds
+}
+
 func getConnectionDCByName(conn *model.ClusterConnectionInformation, dcName string) (*model.DatacenterConnection, error) {
 	if len(conn.Datacenters) == 0 {
 		return nil, fmt.Errorf("error reading datacenter connections: not found")
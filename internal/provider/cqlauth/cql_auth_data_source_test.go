@@ -0,0 +1,51 @@
+package cqlauth
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/scyllatest"
+)
+
+func TestDataSourceCQLAuthReadMultiDC(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, DataSourceCQLAuth().InternalValidate(nil, false))
+
+	c := scyllatest.NewClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/account/7/clusters":
+			_, _ = w.Write([]byte(`{"data":{"clusters":[{"id":41,"clusterName":"other"},{"id":42,"clusterName":"prod"}]}}`))
+		case "/account/7/cluster/connect":
+			_, _ = w.Write([]byte(`{"data":{"broadcastType":"PUBLIC","credentials":{"username":"scylla","password":"secret"},"connectDataCenters":[
+				{"dcName":"AWS_US_EAST_1","publicIPs":["3.3.3.3","3.3.3.4"],"privateIPs":["10.0.0.1","10.0.0.2"],"dns":["node-0.example.com","node-1.example.com"]},
+				{"dcName":"AWS_EU_WEST_1","publicIPs":["4.4.4.4"],"privateIPs":["10.1.0.1"],"dns":["node-3.example.com"]}
+			]}}`))
+		default:
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, DataSourceCQLAuth().Schema, map[string]interface{}{"cluster_id": 42})
+	require.Empty(t, dataSourceCQLAuthRead(context.Background(), d, c))
+
+	require.Equal(t, "42/AWS_US_EAST_1", d.Id())
+	require.Equal(t, "prod", d.Get("cluster_name"))
+	require.Equal(t, "AWS_US_EAST_1", d.Get("datacenter"))
+	require.Equal(t, "node-0.example.com,node-1.example.com", d.Get("seeds"))
+	require.Equal(t, []interface{}{"node-0.example.com", "node-1.example.com"}, d.Get("seed_list"))
+	require.Equal(t, 9042, d.Get("port"))
+	require.Equal(t, 9142, d.Get("tls_port"))
+	require.Equal(t, 2, d.Get("datacenters.#"))
+	require.Equal(t, "AWS_EU_WEST_1", d.Get("datacenters.1.name"))
+	require.Equal(t, []interface{}{"4.4.4.4"}, d.Get("datacenters.1.public_ips"))
+	require.Equal(t, []interface{}{"10.1.0.1"}, d.Get("datacenters.1.private_ips"))
+	require.Equal(t, []interface{}{"node-3.example.com"}, d.Get("datacenters.1.dns"))
+
+	d = schema.TestResourceDataRaw(t, DataSourceCQLAuth().Schema, map[string]interface{}{"cluster_id": 43})
+	require.NotEmpty(t, dataSourceCQLAuthRead(context.Background(), d, c))
+}
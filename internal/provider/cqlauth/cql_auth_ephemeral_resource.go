@@ -0,0 +1,166 @@
+package cqlauth
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/providerdata"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = (*ephemeralCQLAuth)(nil)
+
+// ephemeralCQLAuth is the ephemeral counterpart of the scylladbcloud_cql_auth
+// data source: the credentials are fetched on every run and never stored in
+// the plan or state.
+type ephemeralCQLAuth struct {
+	client *scylla.Client
+}
+
+type ephemeralCQLAuthModel struct {
+	ClusterID   types.Int64  `tfsdk:"cluster_id"`
+	Datacenter  types.String `tfsdk:"datacenter"`
+	DNS         types.Bool   `tfsdk:"dns"`
+	ClusterName types.String `tfsdk:"cluster_name"`
+	Seeds       types.String `tfsdk:"seeds"`
+	SeedList    types.List   `tfsdk:"seed_list"`
+	Port        types.Int64  `tfsdk:"port"`
+	TLSPort     types.Int64  `tfsdk:"tls_port"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+}
+
+func NewEphemeralCQLAuth() ephemeral.EphemeralResource {
+	return &ephemeralCQLAuth{}
+}
+
+func (r *ephemeralCQLAuth) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cql_auth"
+}
+
+func (r *ephemeralCQLAuth) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the CQL credentials of a cluster without storing them in the plan or state, " +
+			"e.g. to pass them to write-only attributes of other providers.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.Int64Attribute{
+				Description: "Cluster ID",
+				Required:    true,
+			},
+			"datacenter": schema.StringAttribute{
+				Description: "Datacenter. Defaults to the first datacenter of the cluster.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"dns": schema.BoolAttribute{
+				Description: "Use DNS names for seeds. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"cluster_name": schema.StringAttribute{
+				Description: "Cluster name",
+				Computed:    true,
+			},
+			"seeds": schema.StringAttribute{
+				Description: "Comma-separate seed node addresses",
+				Computed:    true,
+			},
+			"seed_list": schema.ListAttribute{
+				Description: "Seed node addresses",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"port": schema.Int64Attribute{
+				Description: "CQL port",
+				Computed:    true,
+			},
+			"tls_port": schema.Int64Attribute{
+				Description: "CQL port with client-to-node encryption (TLS)",
+				Computed:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "CQL username",
+				Computed:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "CQL password",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *ephemeralCQLAuth) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	var diags diag.Diagnostics
+	r.client, diags = providerdata.Client(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ephemeralCQLAuth) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var m ephemeralCQLAuthModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &m)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.Append(providerdata.NotConfigured()...)
+		return
+	}
+
+	clusterID := m.ClusterID.ValueInt64()
+	if clusterID < 1 {
+		resp.Diagnostics.AddError("Invalid cluster_id", "cluster_id must be greater than 0")
+		return
+	}
+
+	clusterName, err := getClusterName(ctx, r.client, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading cluster", err.Error())
+		return
+	}
+
+	conn, err := r.client.Connect(ctx, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading connection details", err.Error())
+		return
+	}
+
+	dc, err := getConnectionDCByName(conn, m.Datacenter.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading datacenter", err.Error())
+		return
+	}
+
+	dns := m.DNS.IsNull() || m.DNS.ValueBool()
+
+	seeds, err := getSeeds(dns, dc, conn)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading seeds", err.Error())
+		return
+	}
+
+	seedList, diags := types.ListValueFrom(ctx, types.StringType, SplitSeeds(seeds))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	m.Datacenter = types.StringValue(dc.Name)
+	m.DNS = types.BoolValue(dns)
+	m.ClusterName = types.StringValue(clusterName)
+	m.Seeds = types.StringValue(seeds)
+	m.SeedList = seedList
+	m.Port = types.Int64Value(cqlPort)
+	m.TLSPort = types.Int64Value(cqlTLSPort)
+	m.Username = types.StringValue(conn.Credentials.Username)
+	m.Password = types.StringValue(conn.Credentials.Password)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &m)...)
+}
@@ -0,0 +1,64 @@
+package cqlauth
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/scyllatest"
+)
+
+func TestEphemeralCQLAuthOpen(t *testing.T) {
+	t.Parallel()
+
+	c := scyllatest.NewClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/account/7/clusters":
+			_, _ = w.Write([]byte(`{"data":{"clusters":[{"id":42,"clusterName":"prod"}]}}`))
+		case "/account/7/cluster/connect":
+			_, _ = w.Write([]byte(`{"data":{"broadcastType":"PUBLIC","credentials":{"username":"scylla","password":"secret"},"connectDataCenters":[
+				{"dcName":"AWS_US_EAST_1","publicIPs":["3.3.3.3","3.3.3.4"],"dns":["node-0.example.com","node-1.example.com"]}
+			]}}`))
+		default:
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+	})
+
+	r := NewEphemeralCQLAuth().(*ephemeralCQLAuth)
+	configureResp := &ephemeral.ConfigureResponse{}
+	r.Configure(context.Background(), ephemeral.ConfigureRequest{ProviderData: c}, configureResp)
+	require.Empty(t, configureResp.Diagnostics)
+
+	schemaResp := &ephemeral.SchemaResponse{}
+	r.Schema(context.Background(), ephemeral.SchemaRequest{}, schemaResp)
+	require.Empty(t, schemaResp.Diagnostics)
+	require.Empty(t, schemaResp.Schema.ValidateImplementation(context.Background()))
+
+	typ := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	config := map[string]tftypes.Value{}
+	for name, attrType := range typ.AttributeTypes {
+		config[name] = tftypes.NewValue(attrType, nil)
+	}
+	config["cluster_id"] = tftypes.NewValue(tftypes.Number, 42)
+	config["dns"] = tftypes.NewValue(tftypes.Bool, false)
+
+	req := ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, config)}}
+	resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, nil)}}
+	r.Open(context.Background(), req, resp)
+	require.Empty(t, resp.Diagnostics)
+
+	var got ephemeralCQLAuthModel
+	require.Empty(t, resp.Result.Get(context.Background(), &got))
+	require.Equal(t, "prod", got.ClusterName.ValueString())
+	require.Equal(t, "AWS_US_EAST_1", got.Datacenter.ValueString())
+	require.Equal(t, "3.3.3.3,3.3.3.4", got.Seeds.ValueString())
+	require.Len(t, got.SeedList.Elements(), 2)
+	require.Equal(t, int64(9042), got.Port.ValueInt64())
+	require.Equal(t, "scylla", got.Username.ValueString())
+	require.Equal(t, "secret", got.Password.ValueString())
+}
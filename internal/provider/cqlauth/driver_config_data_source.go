@@ -0,0 +1,305 @@
+package cqlauth
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+)
+
+// CQL ports of ScyllaDB Cloud clusters.
+const (
+	cqlPort    = 9042
+	cqlTLSPort = 9142
+)
+
+func DataSourceDriverConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDriverConfigRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Description: "Cluster ID",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ValidateFunc: func(i interface{}, s string) ([]string, []error) {
+					if i.(int) < 1 {
+						return nil, []error{fmt.Errorf("cluster_id must be greater than 0")}
+					}
+					return nil, nil
+				},
+			},
+			"datacenter": {
+				Description: "The local datacenter of the clients. Defaults to the first datacenter of the cluster.",
+				Optional:    true,
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"dns": {
+				Description: "Use DNS names for seeds",
+				Optional:    true,
+				Default:     true,
+				Type:        schema.TypeBool,
+			},
+			"ca_file": {
+				Description: "The path the clients read the CA certificate from, as written in the generated configurations.",
+				Optional:    true,
+				Default:     "ca.crt",
+				Type:        schema.TypeString,
+			},
+			"datacenters": {
+				Description: "The names of all datacenters of the cluster.",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"seeds": {
+				Description: "The seed node addresses of the local datacenter.",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"port": {
+				Description: "The CQL port, 9142 when TLS is enabled and 9042 otherwise.",
+				Computed:    true,
+				Type:        schema.TypeInt,
+			},
+			"tls_enabled": {
+				Description: "Whether the cluster has client-to-node encryption enabled.",
+				Computed:    true,
+				Type:        schema.TypeBool,
+			},
+			"ca_certificate": {
+				Description: "The PEM-encoded CA certificate to store at `ca_file`, empty when TLS is not enabled.",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"username": {
+				Description: "CQL username",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"password": {
+				Description: "CQL password",
+				Computed:    true,
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+			"load_balancing_policy": {
+				Description: "The recommended load balancing policy: token-aware over datacenter-aware round robin " +
+					"with the local datacenter, e.g. `TokenAwarePolicy(DCAwareRoundRobinPolicy(\"AWS_US_EAST_1\"))`.",
+				Computed: true,
+				Type:     schema.TypeString,
+			},
+			"cqlshrc": {
+				Description: "A cqlshrc file connecting cqlsh to the local datacenter.",
+				Computed:    true,
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+			"java_application_conf": {
+				Description: "A `datastax-java-driver` block for the application.conf of the Java driver.",
+				Computed:    true,
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+			"gocql_yaml": {
+				Description: "A `CQLConnectionConfig` YAML document for `github.com/gocql/gocql/scyllacloud`, " +
+					"with one entry per datacenter and the local datacenter as the current context.",
+				Computed:  true,
+				Sensitive: true,
+				Type:      schema.TypeString,
+			},
+		},
+	}
+}
+
+// driverConfig holds the connection settings the driver configurations are
+// rendered from.
+type driverConfig struct {
+	Datacenter string
+	Seeds      []string
+	Port       int
+	TLS        bool
+	VerifyHost bool
+	CAFile     string
+	Username   string
+	Password   string
+}
+
+func dataSourceDriverConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		c         = meta.(*scylla.Client)
+		clusterID = int64(d.Get("cluster_id").(int))
+		dcName    = d.Get("datacenter").(string)
+		dns       = d.Get("dns").(bool)
+	)
+
+	conn, err := c.Connect(ctx, clusterID)
+	if err != nil {
+		return diag.Errorf("error reading connection details: %s", err)
+	}
+
+	dc, err := getConnectionDCByName(conn, dcName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	seeds, err := getSeeds(dns, dc, conn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var caCert string
+	switch cert, err := c.GetClusterCertificate(ctx, clusterID); {
+	case scylla.IsEncryptionDisabledErr(err):
+	case err != nil:
+		return diag.Errorf("error reading cluster certificate: %s", err)
+	default:
+		caCert = cert.Content
+	}
+
+	cfg := &driverConfig{
+		Datacenter: dc.Name,
+		Seeds:      SplitSeeds(seeds),
+		Port:       cqlPort,
+		TLS:        caCert != "",
+		VerifyHost: dns, // certificates are issued for the DNS names only
+		CAFile:     d.Get("ca_file").(string),
+		Username:   conn.Credentials.Username,
+		Password:   conn.Credentials.Password,
+	}
+	if cfg.TLS {
+		cfg.Port = cqlTLSPort
+	}
+
+	addrType := seedAddressType(dns, conn)
+
+	var dnsNames map[string]string
+	if cfg.TLS && addrType != AddressDNS {
+		nodes, err := c.ListClusterNodes(ctx, clusterID)
+		if err != nil {
+			return diag.Errorf("error reading cluster nodes: %s", err)
+		}
+		dnsNames = nodeDNSNames(nodes)
+	}
+
+	gocqlYAML, err := renderGocqlYAML(conn, dc.Name, addrType, []byte(caCert), dnsNames)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s", clusterID, dc.Name))
+	_ = d.Set("datacenter", dc.Name)
+	_ = d.Set("datacenters", datacenterNames(conn))
+	_ = d.Set("seeds", cfg.Seeds)
+	_ = d.Set("port", cfg.Port)
+	_ = d.Set("tls_enabled", cfg.TLS)
+	_ = d.Set("ca_certificate", caCert)
+	_ = d.Set("username", cfg.Username)
+	_ = d.Set("password", cfg.Password)
+	_ = d.Set("load_balancing_policy", cfg.loadBalancingPolicy())
+	_ = d.Set("cqlshrc", cfg.cqlshrc())
+	_ = d.Set("java_application_conf", cfg.javaApplicationConf())
+	_ = d.Set("gocql_yaml", gocqlYAML)
+
+	return nil
+}
+
+// seedAddressType returns the address type of the seeds getSeeds picks.
+func seedAddressType(dns bool, conn *model.ClusterConnectionInformation) string {
+	switch {
+	case dns:
+		return AddressDNS
+	case strings.EqualFold(conn.BroadcastType, "PRIVATE"):
+		return AddressPrivate
+	default:
+		return AddressPublic
+	}
+}
+
+// renderGocqlYAML renders the connection bundle of the cluster, the format
+// gocql reads with its scyllacloud package.
+func renderGocqlYAML(conn *model.ClusterConnectionInformation, currentDC, addrType string, caCert []byte, dnsNames map[string]string) (string, error) {
+	bundle, err := buildConnectionBundle(conn, currentDC, addrType, caCert, dnsNames)
+	if err != nil {
+		return "", err
+	}
+
+	p, err := yaml.Marshal(bundle)
+	if err != nil {
+		return "", fmt.Errorf("error rendering gocql configuration: %w", err)
+	}
+
+	return string(p), nil
+}
+
+func datacenterNames(conn *model.ClusterConnectionInformation) []string {
+	names := make([]string, 0, len(conn.Datacenters))
+	for _, dc := range conn.Datacenters {
+		names = append(names, dc.Name)
+	}
+	return names
+}
+
+func (cfg *driverConfig) loadBalancingPolicy() string {
+	return fmt.Sprintf("TokenAwarePolicy(DCAwareRoundRobinPolicy(%q))", cfg.Datacenter)
+}
+
+func (cfg *driverConfig) cqlshrc() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "[authentication]\nusername = %s\npassword = %s\n\n", cfg.Username, cfg.Password)
+	fmt.Fprintf(&b, "[connection]\nhostname = %s\nport = %d\n", cfg.Seeds[0], cfg.Port)
+	if cfg.TLS {
+		fmt.Fprintf(&b, "ssl = true\n\n[ssl]\ncertfile = %s\nvalidate = %t\n", cfg.CAFile, cfg.VerifyHost)
+	}
+
+	return b.String()
+}
+
+func (cfg *driverConfig) javaApplicationConf() string {
+	contactPoints := make([]string, 0, len(cfg.Seeds))
+	for _, seed := range cfg.Seeds {
+		contactPoints = append(contactPoints, strconv.Quote(net.JoinHostPort(seed, strconv.Itoa(cfg.Port))))
+	}
+
+	var b strings.Builder
+
+	b.WriteString("datastax-java-driver {\n")
+	b.WriteString("  basic {\n")
+	fmt.Fprintf(&b, "    contact-points = [%s]\n", strings.Join(contactPoints, ", "))
+	fmt.Fprintf(&b, "    load-balancing-policy.local-datacenter = %q\n", cfg.Datacenter)
+	b.WriteString("    request.consistency = LOCAL_QUORUM\n")
+	b.WriteString("  }\n")
+	b.WriteString("  advanced {\n")
+	b.WriteString("    auth-provider {\n")
+	b.WriteString("      class = PlainTextAuthProvider\n")
+	fmt.Fprintf(&b, "      username = %s\n", strconv.Quote(cfg.Username))
+	fmt.Fprintf(&b, "      password = %s\n", strconv.Quote(cfg.Password))
+	b.WriteString("    }\n")
+	if cfg.TLS {
+		fmt.Fprintf(&b, "    # Import %s into the truststore of the JVM.\n", cfg.CAFile)
+		b.WriteString("    ssl-engine-factory {\n")
+		b.WriteString("      class = DefaultSslEngineFactory\n")
+		fmt.Fprintf(&b, "      hostname-validation = %t\n", cfg.VerifyHost)
+		b.WriteString("    }\n")
+	}
+	b.WriteString("  }\n")
+	b.WriteString("}\n")
+
+	return b.String()
+}
@@ -0,0 +1,158 @@
+package cqlauth
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/scyllatest"
+)
+
+func TestDriverConfigRender(t *testing.T) {
+	t.Parallel()
+
+	cfg := &driverConfig{
+		Datacenter: "AWS_US_EAST_1",
+		Seeds:      []string{"node-0.example.com", "node-1.example.com"},
+		Port:       cqlTLSPort,
+		TLS:        true,
+		VerifyHost: true,
+		CAFile:     "/etc/scylla/ca.crt",
+		Username:   "scylla",
+		Password:   `pa"ss`,
+	}
+
+	require.Equal(t, `TokenAwarePolicy(DCAwareRoundRobinPolicy("AWS_US_EAST_1"))`, cfg.loadBalancingPolicy())
+
+	require.Equal(t, `[authentication]
+username = scylla
+password = pa"ss
+
+[connection]
+hostname = node-0.example.com
+port = 9142
+ssl = true
+
+[ssl]
+certfile = /etc/scylla/ca.crt
+validate = true
+`, cfg.cqlshrc())
+
+	require.Equal(t, `datastax-java-driver {
+  basic {
+    contact-points = ["node-0.example.com:9142", "node-1.example.com:9142"]
+    load-balancing-policy.local-datacenter = "AWS_US_EAST_1"
+    request.consistency = LOCAL_QUORUM
+  }
+  advanced {
+    auth-provider {
+      class = PlainTextAuthProvider
+      username = "scylla"
+      password = "pa\"ss"
+    }
+    # Import /etc/scylla/ca.crt into the truststore of the JVM.
+    ssl-engine-factory {
+      class = DefaultSslEngineFactory
+      hostname-validation = true
+    }
+  }
+}
+`, cfg.javaApplicationConf())
+
+	cfg.VerifyHost = false
+	require.Contains(t, cfg.cqlshrc(), "\nvalidate = false\n")
+	require.Contains(t, cfg.javaApplicationConf(), "hostname-validation = false\n")
+
+	cfg.TLS, cfg.Port = false, cqlPort
+	require.NotContains(t, cfg.cqlshrc(), "ssl")
+	require.NotContains(t, cfg.javaApplicationConf(), "ssl-engine-factory")
+}
+
+func TestDataSourceDriverConfigRead(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, DataSourceDriverConfig().InternalValidate(nil, false))
+
+	c := scyllatest.NewClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/account/7/cluster/connect":
+			_, _ = w.Write([]byte(`{"data":{"broadcastType":"PRIVATE","credentials":{"username":"scylla","password":"secret"},"connectDataCenters":[
+				{"dcName":"AWS_US_EAST_1","privateIPs":["10.0.0.1","10.0.0.2"],"dns":["node-0.example.com"]},
+				{"dcName":"AWS_EU_WEST_1","privateIPs":["10.1.0.1"],"dns":["node-3.example.com"]}
+			]}}`))
+		case "/account/7/cluster/42/certificate":
+			_, _ = w.Write([]byte(`{"error":"041201"}`))
+		default:
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, DataSourceDriverConfig().Schema, map[string]interface{}{
+		"cluster_id": 42,
+		"datacenter": "aws_eu_west_1",
+		"dns":        false,
+	})
+	require.Empty(t, dataSourceDriverConfigRead(context.Background(), d, c))
+	require.Equal(t, "42/AWS_EU_WEST_1", d.Id())
+	require.Equal(t, "AWS_EU_WEST_1", d.Get("datacenter"))
+	require.Equal(t, []interface{}{"AWS_US_EAST_1", "AWS_EU_WEST_1"}, d.Get("datacenters"))
+	require.Equal(t, []interface{}{"10.1.0.1"}, d.Get("seeds"))
+	require.Equal(t, cqlPort, d.Get("port"))
+	require.Equal(t, false, d.Get("tls_enabled"))
+	require.Equal(t, "secret", d.Get("password"))
+	require.Contains(t, d.Get("cqlshrc"), "hostname = 10.1.0.1\n")
+	require.Contains(t, d.Get("gocql_yaml"), "currentContext: AWS_EU_WEST_1\n")
+	require.Contains(t, d.Get("gocql_yaml"), "server: 10.1.0.1:9042\n")
+}
+
+func TestRenderGocqlYAML(t *testing.T) {
+	t.Parallel()
+
+	conn := &model.ClusterConnectionInformation{
+		BroadcastType: "PUBLIC",
+		Datacenters: []model.DatacenterConnection{
+			{Name: "AWS_US_EAST_1", PublicIP: []string{"3.3.3.3"}, DNS: []string{"node-0.example.com"}},
+			{Name: "AWS_EU_WEST_1", PublicIP: []string{"4.4.4.4"}, DNS: []string{"node-3.example.com"}},
+		},
+	}
+	conn.Credentials.Username = "scylla"
+	conn.Credentials.Password = "secret"
+	dnsNames := map[string]string{"3.3.3.3": "node-0.example.com", "4.4.4.4": "node-3.example.com"}
+
+	got, err := renderGocqlYAML(conn, "AWS_EU_WEST_1", AddressPublic, []byte("CA"), dnsNames)
+	require.NoError(t, err)
+	require.Equal(t, `apiVersion: cqlclient.scylla.scylladb.com/v1alpha1
+authInfos:
+  default:
+    password: secret
+    username: scylla
+contexts:
+  AWS_EU_WEST_1:
+    authInfoName: default
+    datacenterName: AWS_EU_WEST_1
+  AWS_US_EAST_1:
+    authInfoName: default
+    datacenterName: AWS_US_EAST_1
+currentContext: AWS_EU_WEST_1
+datacenters:
+  AWS_EU_WEST_1:
+    certificateAuthorityData: Q0E=
+    server: 4.4.4.4:9142
+    tlsServerName: node-3.example.com
+  AWS_US_EAST_1:
+    certificateAuthorityData: Q0E=
+    server: 3.3.3.3:9142
+    tlsServerName: node-0.example.com
+kind: CQLConnectionConfig
+parameters:
+  defaultConsistency: LOCAL_QUORUM
+  defaultSerialConsistency: LOCAL_SERIAL
+`, got)
+
+	_, err = renderGocqlYAML(conn, "AWS_EU_WEST_1", AddressPublic, []byte("CA"), nil)
+	require.ErrorContains(t, err, "no PUBLIC_IP address with a known DNS name")
+}
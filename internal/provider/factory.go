@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -11,11 +12,14 @@ import (
 // ProtoV5ProviderServerFactory returns a muxed terraform-plugin-go protocol v5 provider factory function.
 // This factory function is suitable for use with the terraform-plugin-go Serve function.
 // The primary (Plugin SDK) provider server is also returned (useful for testing).
+// The Plugin Framework provider is served after the primary one, as it relies on
+// the client the primary provider configures.
 func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, *schema.Provider, error) {
 	primary := New(ctx)
 
 	servers := []func() tfprotov5.ProviderServer{
 		primary.GRPCProvider,
+		providerserver.NewProtocol5(NewFramework(primary)()),
 	}
 
 	muxServer, err := tf5muxserver.NewMuxServer(ctx, servers...)
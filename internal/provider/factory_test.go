@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/scyllatest"
+)
+
+func TestProtoV5ProviderServerFactory(t *testing.T) {
+	t.Parallel()
+
+	factory, primary, err := ProtoV5ProviderServerFactory(t.Context())
+	require.NoError(t, err)
+	require.NotNil(t, primary)
+
+	resp, err := factory().GetProviderSchema(t.Context(), &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Diagnostics)
+
+	// Served by the SDK provider.
+	require.Contains(t, resp.ResourceSchemas, "scylladbcloud_cluster")
+	require.Contains(t, resp.DataSourceSchemas, "scylladbcloud_cql_auth")
+
+	// Served by the Plugin Framework provider.
+	require.Contains(t, resp.EphemeralResourceSchemas, "scylladbcloud_cql_auth")
+	require.Contains(t, resp.Functions, "seeds_to_list")
+	require.Contains(t, resp.ActionSchemas, "scylladbcloud_cluster_resize")
+	require.Contains(t, resp.ActionSchemas, "scylladbcloud_cluster_wait_idle")
+
+	// List resources are served by the Plugin Framework provider for
+	// resources of the SDK provider, which serves their identities.
+	ids, err := factory().GetResourceIdentitySchemas(t.Context(), &tfprotov5.GetResourceIdentitySchemasRequest{})
+	require.NoError(t, err)
+	require.Empty(t, ids.Diagnostics)
+
+	for _, typeName := range []string{
+		"scylladbcloud_cluster",
+		"scylladbcloud_allowlist_rule",
+		"scylladbcloud_vpc_peering",
+		"scylladbcloud_cluster_connection",
+	} {
+		require.Contains(t, resp.ListResourceSchemas, typeName)
+		require.Contains(t, ids.IdentitySchemas, typeName)
+	}
+}
+
+func TestFrameworkProviderSharesClient(t *testing.T) {
+	t.Parallel()
+
+	primary := New(t.Context())
+	p := NewFramework(primary)()
+
+	// Not configured yet: the framework types get no client.
+	resp := &fwprovider.ConfigureResponse{}
+	p.Configure(t.Context(), fwprovider.ConfigureRequest{}, resp)
+	require.Empty(t, resp.Diagnostics)
+	require.Nil(t, resp.ResourceData)
+
+	c := &scylla.Client{AccountID: 7}
+	primary.SetMeta(c)
+
+	resp = &fwprovider.ConfigureResponse{}
+	p.Configure(t.Context(), fwprovider.ConfigureRequest{}, resp)
+	require.Empty(t, resp.Diagnostics)
+	for _, data := range []any{resp.DataSourceData, resp.ResourceData, resp.EphemeralResourceData, resp.ListResourceData, resp.ActionData} {
+		require.Same(t, c, data)
+	}
+}
+
+func TestFrameworkProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	p := NewFramework(New(t.Context()))()
+
+	resp := &fwprovider.SchemaResponse{}
+	p.Schema(t.Context(), fwprovider.SchemaRequest{}, resp)
+	require.Empty(t, resp.Diagnostics)
+	require.Empty(t, resp.Schema.ValidateImplementation(t.Context()))
+	require.True(t, resp.Schema.Attributes["token"].IsSensitive())
+	require.Len(t, resp.Schema.Attributes, len(New(t.Context()).Schema))
+
+	_, err := frameworkProviderAttributes(map[string]*schema.Schema{
+		"nodes": {Type: schema.TypeInt, Optional: true},
+	})
+	require.EqualError(t, err, `unsupported type TypeInt of provider attribute "nodes"`)
+}
+
+func TestFrameworkListResources(t *testing.T) {
+	t.Parallel()
+
+	c := scyllatest.NewClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/account/7/clusters":
+			_, _ = w.Write([]byte(`{"data":{"clusters":[
+				{"id":2,"clusterName":"staging","status":"ACTIVE","cloudProviderId":1,"dc":{"id":20,"name":"AWS_US_EAST_1","cidrBlock":"172.31.0.0/16","regionID":11,
+					"scaling":{"instanceFamilies":["i4i"],"policies":{"storage":{"min":100,"targetUtilization":0.8},"vcpu":{"min":4}}}}},
+				{"id":1,"clusterName":"prod","status":"ACTIVE","dns":true,"cloudProviderId":1,"dc":{"id":10,"name":"AWS_US_EAST_1","cidrBlock":"172.30.0.0/16","regionID":11,"instanceId":100}},
+				{"id":3,"clusterName":"old","status":"DELETED"}
+			]}}`))
+		case "/account/7/cluster/1/network/firewall/allowed":
+			_, _ = w.Write([]byte(`{"data":[{"id":10,"clusterId":1,"address":"10.0.0.0/24"}]}`))
+		case "/account/7/cluster/2/network/firewall/allowed":
+			_, _ = w.Write([]byte(`{"data":[{"id":20,"clusterId":2,"address":"10.1.0.0/24"},{"id":21,"clusterId":2,"address":"10.2.0.0/24"}]}`))
+		case "/account/7/cluster/1/network/vpc/peer", "/account/7/cluster/2/network/vpc/peer":
+			_, _ = w.Write([]byte(`{"data":[
+				{"id":5,"externalId":"pcx-1","vpcId":"vpc-1","ownerId":"123","cidrList":["192.168.0.0/16"],"regionId":11,"status":"ACTIVE"},
+				{"id":6,"externalId":"","status":"INITIATED"},
+				{"id":7,"externalId":"pcx-2","status":"DELETED"}
+			]}`))
+		case "/account/7/cluster/1/network/vpc/connection", "/account/7/cluster/2/network/vpc/connection":
+			_, _ = w.Write([]byte(`{"data":{"connections":[
+				{"id":30,"name":"tgw","type":"AWS_TGW_ATTACHMENT","clusterDCID":10,"cidrList":["10.5.0.0/16"],"data":{"tgwId":"tgw-1","ramArn":"arn:aws:ram::1:resource-share/1"},"status":"ACTIVE"},
+				{"id":31,"name":"gone","status":"DELETED"}
+			]}}`))
+		case "/account/7/cluster/1/dcs":
+			_, _ = w.Write([]byte(`{"data":{"dataCenters":[{"id":10,"name":"AWS_US_EAST_1"}]}}`))
+		case "/account/7/cluster/1/nodes":
+			_, _ = w.Write([]byte(`{"data":{"nodes":[{"id":1,"status":"ACTIVE"},{"id":2,"status":"ACTIVE"},{"id":3,"status":"DELETED"}]}}`))
+		case "/deployment/cloud-provider/1/region/11":
+			_, _ = w.Write([]byte(`{"data":{"instances":[{"id":100,"externalId":"i4i.large"}]}}`))
+		default:
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+	})
+	c.Meta = &scylla.Cloudmeta{CloudProviders: []scylla.CloudProvider{{
+		CloudProvider:        &model.CloudProvider{ID: 1, Name: "AWS"},
+		CloudProviderRegions: &model.CloudProviderRegions{Regions: []model.CloudProviderRegion{{ID: 11, ExternalID: "us-east-1"}}},
+	}}}
+
+	primary := New(t.Context())
+	primary.SetMeta(c)
+
+	s := providerserver.NewProtocol5(NewFramework(primary)())()
+
+	schemaResp, err := s.GetProviderSchema(t.Context(), &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, schemaResp.Diagnostics)
+
+	providerConfig, err := tfprotov5.NewDynamicValue(schemaResp.Provider.ValueType(), tftypes.NewValue(schemaResp.Provider.ValueType(), nil))
+	require.NoError(t, err)
+	configureResp, err := s.ConfigureProvider(t.Context(), &tfprotov5.ConfigureProviderRequest{Config: &providerConfig})
+	require.NoError(t, err)
+	require.Empty(t, configureResp.Diagnostics)
+
+	identityResp, err := primary.GRPCProvider().GetResourceIdentitySchemas(t.Context(), &tfprotov5.GetResourceIdentitySchemasRequest{})
+	require.NoError(t, err)
+
+	resourceSchemaResp, err := primary.GRPCProvider().GetProviderSchema(t.Context(), &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+
+	// Arguments the resource objects must set besides the required ones,
+	// with their values unless only known to be set.
+	complete := map[string]map[string]tftypes.Value{
+		"prod": {
+			"min_nodes": tftypes.NewValue(tftypes.Number, 2),
+			"node_type": tftypes.NewValue(tftypes.String, "i4i.large"),
+		},
+		"staging": {
+			"scaling": {},
+		},
+		"pcx-1 (cluster 2)": {
+			"datacenter":        tftypes.NewValue(tftypes.String, "AWS_US_EAST_1"),
+			"peer_region":       tftypes.NewValue(tftypes.String, "us-east-1"),
+			"scylla_cidr_block": tftypes.NewValue(tftypes.String, "172.31.0.0/16"),
+		},
+		"tgw (cluster 1)": {
+			"datacenter": tftypes.NewValue(tftypes.String, "AWS_US_EAST_1"),
+			"data":       {},
+		},
+	}
+
+	tests := []struct {
+		typeName string
+		config   map[string]tftypes.Value
+		limit    int64
+		want     []string
+	}{
+		{
+			typeName: "scylladbcloud_cluster",
+			want:     []string{"prod", "staging"},
+		},
+		{
+			typeName: "scylladbcloud_cluster",
+			config:   map[string]tftypes.Value{"name_regex": tftypes.NewValue(tftypes.String, "^stag")},
+			want:     []string{"staging"},
+		},
+		{
+			typeName: "scylladbcloud_allowlist_rule",
+			want:     []string{"10.0.0.0/24 (cluster 1)", "10.1.0.0/24 (cluster 2)", "10.2.0.0/24 (cluster 2)"},
+		},
+		{
+			typeName: "scylladbcloud_allowlist_rule",
+			limit:    2,
+			want:     []string{"10.0.0.0/24 (cluster 1)", "10.1.0.0/24 (cluster 2)"},
+		},
+		{
+			typeName: "scylladbcloud_vpc_peering",
+			config:   map[string]tftypes.Value{"cluster_id": tftypes.NewValue(tftypes.Number, 2)},
+			want:     []string{"pcx-1 (cluster 2)"},
+		},
+		{
+			typeName: "scylladbcloud_cluster_connection",
+			config:   map[string]tftypes.Value{"cluster_id": tftypes.NewValue(tftypes.Number, 1)},
+			want:     []string{"tgw (cluster 1)"},
+		},
+	}
+
+	for _, tt := range tests {
+		typ := schemaResp.ListResourceSchemas[tt.typeName].ValueType().(tftypes.Object)
+		config := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+		for name, attrType := range typ.AttributeTypes {
+			config[name] = tftypes.NewValue(attrType, nil)
+		}
+		for name, v := range tt.config {
+			config[name] = v
+		}
+		dv, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, config))
+		require.NoError(t, err)
+
+		stream, err := s.(tfprotov5.ProviderServerWithListResource).ListResource(t.Context(), &tfprotov5.ListResourceRequest{
+			TypeName:        tt.typeName,
+			Config:          &dv,
+			IncludeResource: true,
+			Limit:           tt.limit,
+		})
+		require.NoError(t, err)
+
+		var got []string
+		for result := range stream.Results {
+			require.Empty(t, result.Diagnostics, tt.typeName)
+			require.NotNil(t, result.Resource, tt.typeName)
+			got = append(got, result.DisplayName)
+
+			// The identity must be complete to import the resource.
+			identityType := identityResp.IdentitySchemas[tt.typeName].ValueType()
+			identity, err := result.Identity.IdentityData.Unmarshal(identityType)
+			require.NoError(t, err)
+			require.True(t, identity.IsFullyKnown(), tt.typeName)
+
+			var attrs map[string]tftypes.Value
+			require.NoError(t, identity.As(&attrs))
+			for name, v := range attrs {
+				require.False(t, v.IsNull(), "%s identity attribute %q", tt.typeName, name)
+			}
+
+			// The resource object must set the required arguments, as
+			// "terraform query -generate-config-out" writes them out.
+			resource, err := result.Resource.Unmarshal(resourceSchemaResp.ResourceSchemas[tt.typeName].ValueType())
+			require.NoError(t, err)
+			require.NoError(t, resource.As(&attrs))
+			for name, sch := range primary.ResourcesMap[tt.typeName].Schema {
+				if sch.Required {
+					require.False(t, attrs[name].IsNull(), "%s argument %q", result.DisplayName, name)
+				}
+			}
+			for name, v := range complete[result.DisplayName] {
+				require.False(t, attrs[name].IsNull(), "%s argument %q", result.DisplayName, name)
+				if v.Type() != nil {
+					require.True(t, v.Equal(attrs[name]), "%s argument %q: %s", result.DisplayName, name, attrs[name])
+				}
+			}
+		}
+		require.Equal(t, tt.want, got, tt.typeName)
+	}
+}
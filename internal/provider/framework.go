@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/allowlistrule"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/cluster"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/connection"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/cqlauth"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/functions"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/vpcpeering"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+)
+
+// frameworkProvider is the Plugin Framework half of the provider, served
+// next to the SDK provider through the mux server. New resources and data
+// sources are built here, as are the types only the framework supports:
+// ephemeral resources, functions, list resources and actions.
+//
+// It has no configuration of its own: it reuses the client configured by the
+// SDK provider, which the mux server configures first. Framework types get
+// the client with providerdata.Client.
+type frameworkProvider struct {
+	primary *schema.Provider
+}
+
+var (
+	_ fwprovider.Provider                       = (*frameworkProvider)(nil)
+	_ fwprovider.ProviderWithEphemeralResources = (*frameworkProvider)(nil)
+	_ fwprovider.ProviderWithFunctions          = (*frameworkProvider)(nil)
+	_ fwprovider.ProviderWithListResources      = (*frameworkProvider)(nil)
+	_ fwprovider.ProviderWithActions            = (*frameworkProvider)(nil)
+)
+
+// NewFramework returns the Plugin Framework provider sharing the client of
+// the primary (SDK) provider.
+func NewFramework(primary *schema.Provider) func() fwprovider.Provider {
+	return func() fwprovider.Provider {
+		return &frameworkProvider{primary: primary}
+	}
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "scylladbcloud"
+}
+
+// Schema mirrors the SDK provider schema, the mux server requires both to be
+// identical.
+func (p *frameworkProvider) Schema(_ context.Context, _ fwprovider.SchemaRequest, resp *fwprovider.SchemaResponse) {
+	attrs, err := frameworkProviderAttributes(p.primary.Schema)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid provider schema", err.Error())
+		return
+	}
+	resp.Schema = fwschema.Schema{Attributes: attrs}
+}
+
+func (p *frameworkProvider) Configure(_ context.Context, _ fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+	// Meta is nil when the configuration is not known yet, e.g. during
+	// validation; resources then report an unconfigured provider.
+	c, ok := p.primary.Meta().(*scylla.Client)
+	if !ok {
+		return
+	}
+
+	resp.DataSourceData = c
+	resp.ResourceData = c
+	resp.EphemeralResourceData = c
+	resp.ListResourceData = c
+	resp.ActionData = c
+}
+
+func (p *frameworkProvider) Resources(context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) DataSources(context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		cqlauth.NewEphemeralCQLAuth,
+	}
+}
+
+// ListResources returns the list resources of resources managed by the SDK
+// provider; they describe those resources with RawV5Schemas.
+func (p *frameworkProvider) ListResources(context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		cluster.NewClusterListResource,
+		allowlistrule.NewAllowlistRuleListResource,
+		vpcpeering.NewVPCPeeringListResource,
+		connection.NewClusterConnectionListResource,
+	}
+}
+
+func (p *frameworkProvider) Actions(context.Context) []func() action.Action {
+	return []func() action.Action{
+		cluster.NewClusterResizeAction,
+		cluster.NewClusterWaitIdleAction,
+	}
+}
+
+func (p *frameworkProvider) Functions(context.Context) []func() function.Function {
+	return functions.All()
+}
+
+// frameworkProviderAttributes converts the top-level SDK provider attributes
+// into Plugin Framework ones.
+func frameworkProviderAttributes(s map[string]*schema.Schema) (map[string]fwschema.Attribute, error) {
+	attrs := make(map[string]fwschema.Attribute, len(s))
+	for k, v := range s {
+		switch {
+		case v.Type == schema.TypeString:
+			attrs[k] = fwschema.StringAttribute{
+				Description: v.Description,
+				Optional:    v.Optional,
+				Required:    v.Required,
+				Sensitive:   v.Sensitive,
+			}
+		case v.Type == schema.TypeBool:
+			attrs[k] = fwschema.BoolAttribute{
+				Description: v.Description,
+				Optional:    v.Optional,
+				Required:    v.Required,
+				Sensitive:   v.Sensitive,
+			}
+		case v.Type == schema.TypeMap && isStringElem(v.Elem):
+			attrs[k] = fwschema.MapAttribute{
+				Description: v.Description,
+				ElementType: types.StringType,
+				Optional:    v.Optional,
+				Required:    v.Required,
+				Sensitive:   v.Sensitive,
+			}
+		default:
+			return nil, fmt.Errorf("unsupported type %s of provider attribute %q", v.Type, k)
+		}
+	}
+	return attrs, nil
+}
+
+func isStringElem(elem interface{}) bool {
+	s, ok := elem.(*schema.Schema)
+	return ok && s.Type == schema.TypeString
+}
@@ -0,0 +1,63 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/schemautils"
+)
+
+var _ function.Function = (*cidrOverlaps)(nil)
+
+type cidrOverlaps struct{}
+
+func NewCIDROverlaps() function.Function {
+	return &cidrOverlaps{}
+}
+
+func (f *cidrOverlaps) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_overlaps"
+}
+
+func (f *cidrOverlaps) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether two CIDR blocks overlap",
+		MarkdownDescription: "Returns whether the two CIDR blocks share any address, e.g. to check that the CIDR block " +
+			"of a cluster does not overlap the network it is peered with.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "a",
+				MarkdownDescription: "CIDR block, e.g. `10.0.0.0/16`.",
+			},
+			function.StringParameter{
+				Name:                "b",
+				MarkdownDescription: "CIDR block, e.g. `10.0.1.0/24`.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *cidrOverlaps) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &a, &b))
+	if resp.Error != nil {
+		return
+	}
+
+	pa, err := schemautils.ParseCIDR(a)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	pb, err := schemautils.ParseCIDR(b)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, pa.Overlaps(pb)))
+}
@@ -0,0 +1,18 @@
+// Package functions implements the provider-defined functions, which are
+// served by the Plugin Framework provider. Functions run without the provider
+// configuration, so they must not depend on the API client.
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// All returns the provider-defined functions.
+func All() []func() function.Function {
+	return []func() function.Function{
+		NewGCPDefaultPeerCIDR,
+		NewValidMinNodes,
+		NewSeedsToList,
+		NewCIDROverlaps,
+	}
+}
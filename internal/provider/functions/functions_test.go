@@ -0,0 +1,145 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func run(t *testing.T, f function.Function, result attr.Value, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	resp := &function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(args)}, resp)
+
+	return resp.Result.Value(), resp.Error
+}
+
+func TestAllDefinitions(t *testing.T) {
+	t.Parallel()
+
+	names := make(map[string]bool)
+	for _, newFunc := range All() {
+		f := newFunc()
+
+		var meta function.MetadataResponse
+		f.Metadata(context.Background(), function.MetadataRequest{}, &meta)
+		require.NotEmpty(t, meta.Name)
+		require.False(t, names[meta.Name], "duplicate function %q", meta.Name)
+		names[meta.Name] = true
+
+		var def function.DefinitionResponse
+		f.Definition(context.Background(), function.DefinitionRequest{}, &def)
+		require.Empty(t, def.Diagnostics)
+
+		var validate function.DefinitionValidateResponse
+		def.Definition.ValidateImplementation(context.Background(), function.DefinitionValidateRequest{FuncName: meta.Name}, &validate)
+		require.Empty(t, validate.Diagnostics)
+	}
+}
+
+func TestGCPDefaultPeerCIDR(t *testing.T) {
+	t.Parallel()
+
+	got, ferr := run(t, NewGCPDefaultPeerCIDR(), types.StringUnknown(), types.StringValue("europe-west1"))
+	require.Nil(t, ferr)
+	require.Equal(t, types.StringValue("10.132.0.0/20"), got)
+
+	_, ferr = run(t, NewGCPDefaultPeerCIDR(), types.StringUnknown(), types.StringValue("mars-north1"))
+	require.NotNil(t, ferr)
+	require.Contains(t, ferr.Text, "mars-north1")
+	require.NotNil(t, ferr.FunctionArgument)
+	require.EqualValues(t, 0, *ferr.FunctionArgument)
+}
+
+func TestValidMinNodes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n     int64
+		valid bool
+	}{
+		{n: 0},
+		{n: 2},
+		{n: 3, valid: true},
+		{n: 4},
+		{n: 6, valid: true},
+		{n: -3},
+	}
+
+	for _, tt := range tests {
+		got, ferr := run(t, NewValidMinNodes(), types.BoolUnknown(), types.Int64Value(tt.n))
+		require.Nil(t, ferr)
+		require.Equal(t, types.BoolValue(tt.valid), got, "n=%d", tt.n)
+	}
+}
+
+func TestSeedsToList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		seeds string
+		want  []string
+	}{
+		{name: "empty", seeds: "", want: []string{}},
+		{name: "single", seeds: "node-0.example.com", want: []string{"node-0.example.com"}},
+		{name: "many", seeds: "10.0.0.1,10.0.0.2,10.0.0.3", want: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}},
+		{name: "whitespace and empty entries", seeds: " 10.0.0.1 ,, 10.0.0.2,", want: []string{"10.0.0.1", "10.0.0.2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ferr := run(t, NewSeedsToList(), types.ListUnknown(types.StringType), types.StringValue(tt.seeds))
+			require.Nil(t, ferr)
+
+			want, diags := types.ListValueFrom(context.Background(), types.StringType, tt.want)
+			require.Empty(t, diags)
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestCIDROverlaps(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		a, b     string
+		overlaps bool
+		errArg   *int64
+	}{
+		{name: "contained", a: "10.0.0.0/16", b: "10.0.1.0/24", overlaps: true},
+		{name: "disjoint", a: "10.0.0.0/24", b: "10.0.1.0/24"},
+		{name: "host bits", a: "10.0.0.1/24", b: "10.0.0.200/32", overlaps: true},
+		{name: "ipv6", a: "fd00::/8", b: "fd12:3456::/32", overlaps: true},
+		{name: "invalid a", a: "10.0.0.0/33", b: "10.0.0.0/24", errArg: ptr(0)},
+		{name: "invalid b", a: "10.0.0.0/24", b: "nope", errArg: ptr(1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ferr := run(t, NewCIDROverlaps(), types.BoolUnknown(), types.StringValue(tt.a), types.StringValue(tt.b))
+			if tt.errArg != nil {
+				require.NotNil(t, ferr)
+				require.Equal(t, tt.errArg, ferr.FunctionArgument)
+				return
+			}
+
+			require.Nil(t, ferr)
+			require.Equal(t, types.BoolValue(tt.overlaps), got)
+		})
+	}
+}
+
+func ptr(i int64) *int64 {
+	return &i
+}
@@ -0,0 +1,60 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+)
+
+var _ function.Function = (*gcpDefaultPeerCIDR)(nil)
+
+type gcpDefaultPeerCIDR struct{}
+
+func NewGCPDefaultPeerCIDR() function.Function {
+	return &gcpDefaultPeerCIDR{}
+}
+
+func (f *gcpDefaultPeerCIDR) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "gcp_default_peer_cidr"
+}
+
+func (f *gcpDefaultPeerCIDR) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Default peer CIDR block of a GCP region",
+		MarkdownDescription: "Returns the default CIDR block of the subnet that is used as `peer_cidr_blocks` of a GCP VPC peering " +
+			"in the given region. Blocks set with the `gcp_peer_cidr_blocks` provider attribute are not taken into account.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "GCP region, e.g. `us-east1`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *gcpDefaultPeerCIDR) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var region string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	blocks, err := scylla.DefaultGCPBlocks()
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	cidr, ok := blocks[region]
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("no default peer CIDR block for GCP region %q", region))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, cidr))
+}
@@ -0,0 +1,48 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/cqlauth"
+)
+
+var _ function.Function = (*seedsToList)(nil)
+
+type seedsToList struct{}
+
+func NewSeedsToList() function.Function {
+	return &seedsToList{}
+}
+
+func (f *seedsToList) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "seeds_to_list"
+}
+
+func (f *seedsToList) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Split comma-separated seeds into a list",
+		MarkdownDescription: "Splits a comma-separated list of seed addresses, such as the `seeds` attribute of " +
+			"`scylladbcloud_cql_auth`, into a list. Whitespace is trimmed and empty entries are dropped.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "seeds",
+				MarkdownDescription: "Comma-separated seed addresses.",
+			},
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *seedsToList) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var seeds string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &seeds))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, cqlauth.SplitSeeds(seeds)))
+}
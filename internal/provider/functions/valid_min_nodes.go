@@ -0,0 +1,47 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/cluster"
+)
+
+var _ function.Function = (*validMinNodes)(nil)
+
+type validMinNodes struct{}
+
+func NewValidMinNodes() function.Function {
+	return &validMinNodes{}
+}
+
+func (f *validMinNodes) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "valid_min_nodes"
+}
+
+func (f *validMinNodes) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check a node count of a Standard cluster",
+		MarkdownDescription: "Returns whether the given number is a valid `min_nodes` of a Standard cluster: " +
+			"at least 3 and divisible by 3.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:                "n",
+				MarkdownDescription: "Number of nodes.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *validMinNodes) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var n int64
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &n))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, cluster.ValidateMinNodes(int(n)) == nil))
+}
@@ -0,0 +1,137 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+)
+
+func DataSourceAvailabilityZones() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAvailabilityZonesRead,
+
+		Schema: map[string]*schema.Schema{
+			"cloud": {
+				Description: "The cloud provider (AWS or GCP).",
+				Optional:    true,
+				Default:     "AWS",
+				Type:        schema.TypeString,
+			},
+			"region": {
+				Description: "The cloud region (e.g. us-east-1).",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"byoa_id": {
+				Description: "The ID of your account (BYOA) in ScyllaDB Cloud. Zone names are specific to " +
+					"the cloud account, so set it to match `byoa_id` of `scylladbcloud_cluster`. " +
+					"Defaults to the account owned by ScyllaDB.",
+				Optional: true,
+				Type:     schema.TypeInt,
+			},
+			"zone_ids": {
+				Description: "The availability zone IDs (e.g. use1-az1), as used by the `availability_zone_ids` attribute of `scylladbcloud_cluster`.",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Description: "The availability zone names (e.g. us-east-1a), in the order of `zone_ids`.",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"zones": {
+				Description: "The availability zones, ordered by zone ID.",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"zone_id": {
+						Description: "The availability zone ID.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"name": {
+						Description: "The availability zone name in the cloud account.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+				}},
+			},
+		},
+	}
+}
+
+func dataSourceAvailabilityZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		c              = meta.(*scylla.Client)
+		cloud          = d.Get("cloud").(string)
+		region         = d.Get("region").(string)
+		cloudAccountID = int64(d.Get("byoa_id").(int))
+	)
+
+	m, err := Cloudmeta(ctx, c)
+	if err != nil {
+		return diag.Errorf("error reading metadata: %s", err)
+	}
+
+	p := m.ProviderByName(cloud)
+	if p == nil {
+		return diag.Errorf(`unrecognized value %q for "cloud" attribute`, cloud)
+	}
+
+	r := p.RegionByName(region)
+	if r == nil {
+		return diag.Errorf(`unrecognized value %q for "region" attribute`, region)
+	}
+
+	// Without byoa_id, look up the active cloud account owned by Scylla.
+	if cloudAccountID == 0 {
+		cloudAccounts, err := c.ListCloudAccounts(ctx)
+		if err != nil {
+			return diag.Errorf("failed to list cloud accounts: %s", err)
+		}
+
+		ca := model.FindScyllaCloudAccount(cloudAccounts, p.CloudProvider.ID)
+		if ca == nil {
+			return diag.Errorf(
+				"no active Scylla-owned cloud account found for cloud provider %q (ID %d)",
+				cloud, p.CloudProvider.ID,
+			)
+		}
+		cloudAccountID = ca.ID
+	}
+
+	zones, err := c.ListAvailabilityZones(ctx, cloudAccountID, r.ID)
+	if err != nil {
+		return diag.Errorf("failed to list availability zones for region %q: %s", region, err)
+	}
+
+	var (
+		ids   = make([]string, 0, len(zones))
+		names = make([]string, 0, len(zones))
+		flat  = make([]map[string]interface{}, 0, len(zones))
+	)
+
+	for _, z := range zones {
+		ids = append(ids, z.ID)
+		names = append(names, z.Name)
+		flat = append(flat, map[string]interface{}{
+			"zone_id": z.ID,
+			"name":    z.Name,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", strings.ToUpper(cloud), r.ExternalID, cloudAccountID))
+	_ = d.Set("zone_ids", ids)
+	_ = d.Set("names", names)
+	_ = d.Set("zones", flat)
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+)
+
+func DataSourceInstanceTypes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInstanceTypesRead,
+
+		Schema: map[string]*schema.Schema{
+			"cloud": {
+				Description: "The cloud provider (AWS or GCP).",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"region": {
+				Description: "The cloud region (e.g. us-east-1).",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"family": {
+				Description: "Only return instance types of this family (e.g. i8g).",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"environment": {
+				Description: "Only return instance types available in this environment (e.g. PRODUCTION).",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"min_cpu_count": {
+				Description: "Only return instance types with at least this many vCPUs.",
+				Optional:    true,
+				Type:        schema.TypeInt,
+			},
+			"min_memory": {
+				Description: "Only return instance types with at least this much memory, in MB.",
+				Optional:    true,
+				Type:        schema.TypeInt,
+			},
+			"min_total_storage": {
+				Description: "Only return instance types with at least this much storage, in GB.",
+				Optional:    true,
+				Type:        schema.TypeInt,
+			},
+			"names": {
+				Description: "The names of the matching instance types (e.g. i8g.large).",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"instance_types": {
+				Description: "The matching instance types.",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The name of the instance type, as used by the `node_type` attribute of `scylladbcloud_cluster`.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"family": {
+						Description: "The instance family.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"environment": {
+						Description: "The environment the instance type is available in.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"cpu_count": {
+						Description: "The number of vCPUs.",
+						Computed:    true,
+						Type:        schema.TypeInt,
+					},
+					"memory": {
+						Description: "The memory size in MB.",
+						Computed:    true,
+						Type:        schema.TypeInt,
+					},
+					"total_storage": {
+						Description: "The storage size in GB, as used by the `node_disk_size` attribute of `scylladbcloud_cluster`.",
+						Computed:    true,
+						Type:        schema.TypeInt,
+					},
+					"local_disk_count": {
+						Description: "The number of local disks.",
+						Computed:    true,
+						Type:        schema.TypeInt,
+					},
+					"network_speed": {
+						Description: "The network speed.",
+						Computed:    true,
+						Type:        schema.TypeInt,
+					},
+					"default": {
+						Description: "Whether the instance type is the default one of its group.",
+						Computed:    true,
+						Type:        schema.TypeBool,
+					},
+				}},
+			},
+		},
+	}
+}
+
+// instanceTypesFilter selects instance types; zero fields match everything.
+type instanceTypesFilter struct {
+	Family          string
+	Environment     string
+	MinCPUCount     int64
+	MinMemory       int64
+	MinTotalStorage int64
+}
+
+func (f *instanceTypesFilter) match(i *model.CloudProviderInstance) bool {
+	switch {
+	case f.Family != "" && !strings.EqualFold(f.Family, i.Family):
+		return false
+	case f.Environment != "" && !strings.EqualFold(f.Environment, i.Environment):
+		return false
+	case i.CPUCount < f.MinCPUCount, i.Memory < f.MinMemory, i.TotalStorage < f.MinTotalStorage:
+		return false
+	}
+	return true
+}
+
+func dataSourceInstanceTypesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		c      = meta.(*scylla.Client)
+		cloud  = d.Get("cloud").(string)
+		region = d.Get("region").(string)
+		f      = instanceTypesFilter{
+			Family:          d.Get("family").(string),
+			Environment:     d.Get("environment").(string),
+			MinCPUCount:     int64(d.Get("min_cpu_count").(int)),
+			MinMemory:       int64(d.Get("min_memory").(int)),
+			MinTotalStorage: int64(d.Get("min_total_storage").(int)),
+		}
+	)
+
+	m, err := Cloudmeta(ctx, c)
+	if err != nil {
+		return diag.Errorf("error reading metadata: %s", err)
+	}
+
+	p := m.ProviderByName(cloud)
+	if p == nil {
+		return diag.Errorf(`unrecognized value %q for "cloud" attribute`, cloud)
+	}
+
+	r := p.RegionByName(region)
+	if r == nil {
+		return diag.Errorf(`unrecognized value %q for "region" attribute`, region)
+	}
+
+	instances, err := c.ListCloudProviderInstancesPerRegion(ctx, p.CloudProvider.ID, r.ID)
+	if err != nil {
+		return diag.Errorf("failed to list cloud provider instances for region %q: %s", region, err)
+	}
+
+	var (
+		names []string
+		types []map[string]interface{}
+	)
+
+	for i := range instances {
+		it := &instances[i]
+		if !f.match(it) {
+			continue
+		}
+		names = append(names, it.ExternalID)
+		types = append(types, map[string]interface{}{
+			"name":             it.ExternalID,
+			"family":           it.Family,
+			"environment":      it.Environment,
+			"cpu_count":        it.CPUCount,
+			"memory":           it.Memory,
+			"total_storage":    it.TotalStorage,
+			"local_disk_count": it.LocalDiskCount,
+			"network_speed":    it.NetworkSpeed,
+			"default":          it.GroupDefault,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", strings.ToUpper(cloud), r.ExternalID))
+	_ = d.Set("names", names)
+	_ = d.Set("instance_types", types)
+
+	return nil
+}
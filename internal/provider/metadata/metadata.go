@@ -0,0 +1,18 @@
+// Package metadata implements data sources exposing the ScyllaDB Cloud
+// deployment metadata: cloud regions, instance types and Scylla versions.
+package metadata
+
+import (
+	"context"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+)
+
+// Cloudmeta returns the metadata preloaded by the provider, or loads it when
+// the provider was configured with metadata = false.
+func Cloudmeta(ctx context.Context, c *scylla.Client) (*scylla.Cloudmeta, error) {
+	if c.Meta != nil {
+		return c.Meta, nil
+	}
+	return scylla.BuildCloudmeta(ctx, c)
+}
@@ -0,0 +1,168 @@
+package metadata
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/scyllatest"
+)
+
+var testMeta = &scylla.Cloudmeta{
+	CloudProviders: []scylla.CloudProvider{{
+		CloudProvider: &model.CloudProvider{ID: 1, Name: "AWS"},
+		CloudProviderRegions: &model.CloudProviderRegions{
+			DefaultRegionID: 11,
+			Regions: []model.CloudProviderRegion{
+				{ID: 10, ExternalID: "eu-west-1", FullName: "Europe (Ireland)", Continent: "Europe", DatacenterName: "AWS_EU_WEST_1"},
+				{ID: 11, ExternalID: "us-east-1", FullName: "US East (N. Virginia)", Continent: "North America", DatacenterName: "AWS_US_EAST_1"},
+			},
+		},
+	}, {
+		CloudProvider: &model.CloudProvider{ID: 2, Name: "GCP"},
+		CloudProviderRegions: &model.CloudProviderRegions{
+			Regions: []model.CloudProviderRegion{
+				{ID: 20, ExternalID: "us-central1", DatacenterName: "GCE_US_CENTRAL_1"},
+			},
+		},
+	}},
+	ScyllaVersions: &model.ScyllaVersions{
+		DefaultScyllaVersionID: 2,
+		ScyllaVersions: []model.ScyllaVersion{
+			{ID: 1, Version: "2024.2.0", NewCluster: "DISABLED"},
+			{ID: 2, Version: "2025.1.4", NewCluster: "ENABLED"},
+		},
+	},
+}
+
+func TestDataSourcesSchema(t *testing.T) {
+	t.Parallel()
+
+	for name, ds := range map[string]*schema.Resource{
+		"regions":            DataSourceRegions(),
+		"instance_types":     DataSourceInstanceTypes(),
+		"scylla_versions":    DataSourceScyllaVersions(),
+		"availability_zones": DataSourceAvailabilityZones(),
+	} {
+		require.NoError(t, ds.InternalValidate(nil, false), name)
+	}
+}
+
+func TestDataSourceRegionsRead(t *testing.T) {
+	t.Parallel()
+
+	c := &scylla.Client{Meta: testMeta}
+
+	d := schema.TestResourceDataRaw(t, DataSourceRegions().Schema, map[string]interface{}{"cloud": "aws"})
+	require.Empty(t, dataSourceRegionsRead(context.Background(), d, c))
+	require.Equal(t, "AWS", d.Id())
+	require.Equal(t, []interface{}{"eu-west-1", "us-east-1"}, d.Get("names"))
+	require.Equal(t, map[string]interface{}{
+		"cloud":      "AWS",
+		"name":       "us-east-1",
+		"full_name":  "US East (N. Virginia)",
+		"continent":  "North America",
+		"datacenter": "AWS_US_EAST_1",
+		"default":    true,
+	}, d.Get("regions.1"))
+
+	d = schema.TestResourceDataRaw(t, DataSourceRegions().Schema, map[string]interface{}{})
+	require.Empty(t, dataSourceRegionsRead(context.Background(), d, c))
+	require.Equal(t, []interface{}{"eu-west-1", "us-east-1", "us-central1"}, d.Get("names"))
+
+	d = schema.TestResourceDataRaw(t, DataSourceRegions().Schema, map[string]interface{}{"cloud": "azure"})
+	require.NotEmpty(t, dataSourceRegionsRead(context.Background(), d, c))
+}
+
+func TestDataSourceScyllaVersionsRead(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, DataSourceScyllaVersions().Schema, map[string]interface{}{})
+	require.Empty(t, dataSourceScyllaVersionsRead(context.Background(), d, &scylla.Client{Meta: testMeta}))
+	require.Equal(t, "2025.1.4", d.Get("default_version"))
+	require.Equal(t, []interface{}{"2024.2.0", "2025.1.4"}, d.Get("names"))
+	require.Equal(t, false, d.Get("versions.0.default"))
+	require.Equal(t, true, d.Get("versions.1.default"))
+}
+
+func TestInstanceTypesFilter(t *testing.T) {
+	t.Parallel()
+
+	i := model.CloudProviderInstance{
+		ExternalID:   "i8g.large",
+		Family:       "i8g",
+		Environment:  "PRODUCTION",
+		CPUCount:     2,
+		Memory:       16384,
+		TotalStorage: 468,
+	}
+
+	tests := []struct {
+		name   string
+		filter instanceTypesFilter
+		want   bool
+	}{
+		{name: "empty", want: true},
+		{name: "family", filter: instanceTypesFilter{Family: "I8G"}, want: true},
+		{name: "other family", filter: instanceTypesFilter{Family: "i4i"}},
+		{name: "environment", filter: instanceTypesFilter{Environment: "production"}, want: true},
+		{name: "other environment", filter: instanceTypesFilter{Environment: "DEVELOPMENT"}},
+		{name: "cpu count", filter: instanceTypesFilter{MinCPUCount: 2}, want: true},
+		{name: "too few cpus", filter: instanceTypesFilter{MinCPUCount: 4}},
+		{name: "memory", filter: instanceTypesFilter{MinMemory: 16384}, want: true},
+		{name: "too little memory", filter: instanceTypesFilter{MinMemory: 32768}},
+		{name: "storage", filter: instanceTypesFilter{MinTotalStorage: 400}, want: true},
+		{name: "too little storage", filter: instanceTypesFilter{MinTotalStorage: 1000}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, tt.filter.match(&i))
+		})
+	}
+}
+
+func TestDataSourceAvailabilityZonesRead(t *testing.T) {
+	t.Parallel()
+
+	c := scyllatest.NewClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/account/7/cloud-account":
+			_, _ = w.Write([]byte(`{"data":[
+				{"id":4,"cloudProviderId":1,"owner":"Customer","state":"ACTIVE"},
+				{"id":5,"cloudProviderId":1,"owner":"Scylla","state":"ACTIVE"}
+			]}`))
+		case "/account/7/cloud-account/5/region/11/zones":
+			_, _ = w.Write([]byte(`{"data":[
+				{"id":"use1-az4","name":"us-east-1b"},
+				{"id":"use1-az2","name":"us-east-1a"}
+			]}`))
+		case "/account/7/cloud-account/1001/region/11/zones":
+			_, _ = w.Write([]byte(`{"data":[{"id":"use1-az2","name":"us-east-1c"}]}`))
+		default:
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+	})
+	c.Meta = testMeta
+
+	d := schema.TestResourceDataRaw(t, DataSourceAvailabilityZones().Schema, map[string]interface{}{"region": "us-east-1"})
+	require.Empty(t, dataSourceAvailabilityZonesRead(context.Background(), d, c))
+	require.Equal(t, "AWS/us-east-1/5", d.Id())
+	require.Equal(t, []interface{}{"use1-az2", "use1-az4"}, d.Get("zone_ids"))
+	require.Equal(t, []interface{}{"us-east-1a", "us-east-1b"}, d.Get("names"))
+	require.Equal(t, "us-east-1b", d.Get("zones.1.name"))
+
+	d = schema.TestResourceDataRaw(t, DataSourceAvailabilityZones().Schema, map[string]interface{}{"region": "us-east-1", "byoa_id": 1001})
+	require.Empty(t, dataSourceAvailabilityZonesRead(context.Background(), d, c))
+	require.Equal(t, []interface{}{"us-east-1c"}, d.Get("names"))
+
+	d = schema.TestResourceDataRaw(t, DataSourceAvailabilityZones().Schema, map[string]interface{}{"cloud": "GCP", "region": "us-central1"})
+	require.NotEmpty(t, dataSourceAvailabilityZonesRead(context.Background(), d, c))
+}
@@ -0,0 +1,121 @@
+package metadata
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+)
+
+func DataSourceRegions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRegionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"cloud": {
+				Description: "Only return regions of this cloud provider (AWS or GCP).",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"names": {
+				Description: "The names of the regions (e.g. us-east-1).",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"regions": {
+				Description: "The regions clusters can be deployed in.",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"cloud": {
+						Description: "The cloud provider of the region.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"name": {
+						Description: "The name of the region (e.g. us-east-1), as used by the `region` attribute of `scylladbcloud_cluster`.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"full_name": {
+						Description: "The human-readable name of the region.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"continent": {
+						Description: "The continent the region is located on.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"datacenter": {
+						Description: "The name of the datacenter created for a cluster in the region (e.g. AWS_US_EAST_1).",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"default": {
+						Description: "Whether the region is the default one of the cloud provider.",
+						Computed:    true,
+						Type:        schema.TypeBool,
+					},
+				}},
+			},
+		},
+	}
+}
+
+func dataSourceRegionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		c     = meta.(*scylla.Client)
+		cloud = d.Get("cloud").(string)
+	)
+
+	m, err := Cloudmeta(ctx, c)
+	if err != nil {
+		return diag.Errorf("error reading metadata: %s", err)
+	}
+
+	if cloud != "" && m.ProviderByName(cloud) == nil {
+		return diag.Errorf(`unrecognized value %q for "cloud" attribute`, cloud)
+	}
+
+	var (
+		names   []string
+		regions []map[string]interface{}
+	)
+
+	for _, p := range m.CloudProviders {
+		if cloud != "" && !strings.EqualFold(p.CloudProvider.Name, cloud) {
+			continue
+		}
+		for _, r := range p.CloudProviderRegions.Regions {
+			names = append(names, r.ExternalID)
+			regions = append(regions, map[string]interface{}{
+				"cloud":      p.CloudProvider.Name,
+				"name":       r.ExternalID,
+				"full_name":  r.FullName,
+				"continent":  r.Continent,
+				"datacenter": r.DatacenterName,
+				"default":    r.ID == p.CloudProviderRegions.DefaultRegionID,
+			})
+		}
+	}
+
+	d.SetId(strings.ToUpper(nonempty(cloud, "all")))
+	_ = d.Set("names", names)
+	_ = d.Set("regions", regions)
+
+	return nil
+}
+
+func nonempty(s ...string) string {
+	for _, v := range s {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,92 @@
+package metadata
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+)
+
+func DataSourceScyllaVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScyllaVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"default_version": {
+				Description: "The Scylla version new clusters use when `scylla_version` is not set.",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"names": {
+				Description: "The Scylla versions (e.g. 2025.1.4).",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"versions": {
+				Description: "The Scylla versions known to ScyllaDB Cloud.",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"version": {
+						Description: "The Scylla version, as used by the `scylla_version` attribute of `scylladbcloud_cluster`.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"description": {
+						Description: "The description of the version.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"new_cluster": {
+						Description: "Whether new clusters can be created with the version, as reported by the API.",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"default": {
+						Description: "Whether the version is the default one for new clusters.",
+						Computed:    true,
+						Type:        schema.TypeBool,
+					},
+				}},
+			},
+		},
+	}
+}
+
+func dataSourceScyllaVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*scylla.Client)
+
+	m, err := Cloudmeta(ctx, c)
+	if err != nil {
+		return diag.Errorf("error reading metadata: %s", err)
+	}
+
+	var (
+		names    []string
+		versions []map[string]interface{}
+		def      = m.ScyllaVersions.DefaultScyllaVersionID
+	)
+
+	for _, v := range m.ScyllaVersions.ScyllaVersions {
+		names = append(names, v.Version)
+		versions = append(versions, map[string]interface{}{
+			"version":     v.Version,
+			"description": v.Description,
+			"new_cluster": v.NewCluster,
+			"default":     v.ID == def,
+		})
+	}
+
+	if v := m.DefaultVersion(); v != nil {
+		_ = d.Set("default_version", v.Version)
+	}
+
+	d.SetId("scylla_versions")
+	_ = d.Set("names", names)
+	_ = d.Set("versions", versions)
+
+	return nil
+}
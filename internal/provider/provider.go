@@ -6,12 +6,15 @@ import (
 	"runtime"
 
 	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/allowlistrule"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/cloudaccount"
 	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/cluster"
 	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/connection"
 	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/cqlauth"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/metadata"
 	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/serverless"
 	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/stack"
 	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/vpcpeering"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/schemautils"
 	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
 	v2scylla "github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/v2"
 
@@ -91,11 +94,31 @@ func New(context.Context) *schema.Provider {
 					"call. It can be set with SCYLLADB_CLOUD_TRACE and is normally supplied " +
 					"by ScyllaDB Cloud tooling. A random ID is generated when unset.",
 			},
+			"gcp_peer_cidr_blocks": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validateGCPPeerCIDRBlocks,
+				Description: "Default peer CIDR blocks of GCP regions (e.g. `{ \"us-central1\" = \"10.128.0.0/20\" }`), " +
+					"used by `scylladbcloud_vpc_peering` when `peer_cidr_blocks` is omitted. Entries override " +
+					"or extend the table of auto mode VPC subnet ranges built into the provider, which lets " +
+					"new regions be peered without a provider release.",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"scylladbcloud_cql_auth":          cqlauth.DataSourceCQLAuth(),
-			"scylladbcloud_serverless_bundle": serverless.DataSourceServerlessBundle(),
+			"scylladbcloud_availability_zones": metadata.DataSourceAvailabilityZones(),
+			"scylladbcloud_cloud_accounts":     cloudaccount.DataSourceCloudAccounts(),
+			"scylladbcloud_cluster":            cluster.DataSourceCluster(),
+			"scylladbcloud_cluster_requests":   cluster.DataSourceClusterRequests(),
+			"scylladbcloud_clusters":           cluster.DataSourceClusters(),
+			"scylladbcloud_connection_bundle":  cqlauth.DataSourceConnectionBundle(),
+			"scylladbcloud_cql_auth":           cqlauth.DataSourceCQLAuth(),
+			"scylladbcloud_driver_config":      cqlauth.DataSourceDriverConfig(),
+			"scylladbcloud_instance_types":     metadata.DataSourceInstanceTypes(),
+			"scylladbcloud_regions":            metadata.DataSourceRegions(),
+			"scylladbcloud_scylla_versions":    metadata.DataSourceScyllaVersions(),
+			"scylladbcloud_serverless_bundle":  serverless.DataSourceServerlessBundle(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -104,6 +127,7 @@ func New(context.Context) *schema.Provider {
 			"scylladbcloud_vpc_peering":        vpcpeering.ResourceVPCPeering(),
 			"scylladbcloud_serverless_cluster": serverless.ResourceServerlessCluster(),
 			"scylladbcloud_cluster_connection": connection.ResourceClusterConnection(),
+			"scylladbcloud_cluster_ready":      cluster.ResourceClusterReady(),
 			"scylladbcloud_stack":              stack.ResourceStack(),
 		},
 	}
@@ -139,9 +163,27 @@ func configure(ctx context.Context, p *schema.Provider, d *schema.ResourceData)
 		return nil, diag.Errorf("could not create new Scylla client: %s", err)
 	}
 
+	if c.Meta != nil {
+		blocks := d.Get("gcp_peer_cidr_blocks").(map[string]interface{})
+		c.Meta.AddGCPBlocks(schemautils.ConvertMapToConcrete[string](blocks))
+	}
+
 	return c, nil
 }
 
+func validateGCPPeerCIDRBlocks(v any, path cty.Path) diag.Diagnostics {
+	blocks, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	for region, cidr := range blocks {
+		diags = append(diags, schemautils.ValidateCIDRDiag(cidr, path.IndexString(region))...)
+	}
+	return diags
+}
+
 // traceOrNew returns the configured trace or a freshly generated one.
 func traceOrNew(configured string) (string, error) {
 	if configured != "" {
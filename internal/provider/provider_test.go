@@ -12,10 +12,12 @@ import (
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -23,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
@@ -994,6 +997,103 @@ func TestAccScyllaDBCloudCluster_migrationV1ToV2(t *testing.T) {
 	})
 }
 
+func TestAccScyllaDBCloudClusterDataSource_basic(t *testing.T) {
+	ctx := t.Context()
+	resourceName := acctest.RandomWithPrefix("ds-cluster")
+
+	var attrs []resource.TestCheckFunc
+	for _, attr := range []string{"cluster_id", "name", "cloud", "region", "node_type", "node_count", "cidr_block", "datacenter", "ca_certificate", "encryption_at_rest.#"} {
+		attrs = append(attrs,
+			resource.TestCheckResourceAttrPair("data.scylladbcloud_cluster.by_id", attr, "scylladbcloud_cluster.test", attr),
+			resource.TestCheckResourceAttrPair("data.scylladbcloud_cluster.by_name", attr, "scylladbcloud_cluster.test", attr),
+		)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScyllaDBCloudClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`resource "scylladbcloud_cluster" "test" {
+  name                  = %[1]q
+  cloud                 = "AWS"
+  region                = "us-east-1"
+  node_type             = "i3.large"
+  min_nodes             = 3
+  cidr_block            = "10.0.1.0/24"
+  backup_retention_days = 0
+}
+
+data "scylladbcloud_cluster" "by_id" {
+  cluster_id = scylladbcloud_cluster.test.cluster_id
+}
+
+data "scylladbcloud_cluster" "by_name" {
+  name = scylladbcloud_cluster.test.name
+}`, resourceName),
+				Check: resource.ComposeTestCheckFunc(attrs...),
+			},
+		},
+	})
+}
+
+// TestAccScyllaDBCloudCQLAuthEphemeral exercises both servers of the mux: the
+// cluster is managed by the SDK provider and the ephemeral resource is served
+// by the Plugin Framework provider.
+func TestAccScyllaDBCloudCQLAuthEphemeral(t *testing.T) {
+	ctx := t.Context()
+	resourceName := acctest.RandomWithPrefix("ephemeral-cql-auth")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(tfversion.Version1_10_0)},
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		CheckDestroy: testAccCheckScyllaDBCloudClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`resource "scylladbcloud_cluster" "test" {
+  name                  = %[1]q
+  cloud                 = "AWS"
+  region                = "us-east-1"
+  node_type             = "i3.large"
+  min_nodes             = 3
+  cidr_block            = "10.0.1.0/24"
+  backup_retention_days = 0
+}
+
+data "scylladbcloud_cql_auth" "test" {
+  cluster_id = scylladbcloud_cluster.test.cluster_id
+}
+
+ephemeral "scylladbcloud_cql_auth" "test" {
+  cluster_id = scylladbcloud_cluster.test.cluster_id
+}
+
+provider "echo" {
+  data = {
+    cluster_name = ephemeral.scylladbcloud_cql_auth.test.cluster_name
+    datacenter   = ephemeral.scylladbcloud_cql_auth.test.datacenter
+    seeds        = ephemeral.scylladbcloud_cql_auth.test.seeds
+    username     = ephemeral.scylladbcloud_cql_auth.test.username
+  }
+}
+
+resource "echo" "test" {}`, resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.test", "data.cluster_name", resourceName),
+					resource.TestCheckResourceAttrPair("echo.test", "data.datacenter", "data.scylladbcloud_cql_auth.test", "datacenter"),
+					resource.TestCheckResourceAttrPair("echo.test", "data.seeds", "data.scylladbcloud_cql_auth.test", "seeds"),
+					resource.TestCheckResourceAttrPair("echo.test", "data.username", "data.scylladbcloud_cql_auth.test", "username"),
+				),
+			},
+		},
+	})
+}
+
 func TestTraceOrNew(t *testing.T) {
 	t.Run("configured trace is preserved", func(t *testing.T) {
 		trace, err := traceOrNew("explicit")
@@ -1029,6 +1129,23 @@ func TestProviderTraceValidation(t *testing.T) {
 	require.Equal(t, "invalid trace value", diags[0].Summary)
 }
 
+func TestProviderGCPPeerCIDRBlocksValidation(t *testing.T) {
+	p := New(context.Background())
+	validate := p.Schema["gcp_peer_cidr_blocks"].ValidateDiagFunc
+
+	require.Nil(t, validate(map[string]interface{}{
+		"us-central1":  "10.128.0.0/20",
+		"me-central99": "10.250.0.0/20",
+	}, cty.Path{}))
+
+	diags := validate(map[string]interface{}{
+		"us-central1": "10.128.0.0/20",
+		"bad-region":  "10.128.0.0/40",
+	}, cty.Path{})
+	require.Len(t, diags, 1)
+	require.Equal(t, diag.Error, diags[0].Severity)
+}
+
 var configureProviderOnce sync.Once
 
 func testAccPreCheck(t *testing.T) {
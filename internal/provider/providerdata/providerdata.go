@@ -0,0 +1,45 @@
+// Package providerdata hands the client configured by the SDK provider to the
+// resources, data sources and other types of the Plugin Framework provider.
+package providerdata
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+)
+
+// Client returns the client passed as provider data to the Configure method
+// of a framework type. It returns nil without diagnostics when the provider is
+// not configured yet, as Configure is also called before the provider
+// configuration is known.
+func Client(data any) (*scylla.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if data == nil {
+		return nil, diags
+	}
+
+	c, ok := data.(*scylla.Client)
+	if !ok {
+		diags.AddError(
+			"Unexpected provider data",
+			fmt.Sprintf("Expected *scylla.Client, got %T. This is a bug in the provider, please report it.", data),
+		)
+		return nil, diags
+	}
+
+	return c, diags
+}
+
+// NotConfigured reports a framework type used before the provider was
+// configured.
+func NotConfigured() diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.AddError(
+		"Provider not configured",
+		"The ScyllaDB Cloud provider must be configured before it can call the API.",
+	)
+	return diags
+}
@@ -0,0 +1,27 @@
+package providerdata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+)
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	c, diags := Client(nil)
+	require.Nil(t, c)
+	require.False(t, diags.HasError())
+
+	want := &scylla.Client{AccountID: 7}
+	c, diags = Client(want)
+	require.Same(t, want, c)
+	require.False(t, diags.HasError())
+
+	c, diags = Client("client")
+	require.Nil(t, c)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Detail(), "got string")
+}
@@ -2,11 +2,15 @@ package serverless
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -35,6 +39,84 @@ func DataSourceServerlessBundle() *schema.Resource {
 				Sensitive:   true,
 				Type:        schema.TypeString,
 			},
+			"datacenter": {
+				Description: "Name of the datacenter of the current context",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"server": {
+				Description: "Host of the current datacenter",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"port": {
+				Description: "Port of the current datacenter",
+				Computed:    true,
+				Type:        schema.TypeInt,
+			},
+			"node_domain": {
+				Description: "Node domain of the current datacenter",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"tls_server_name": {
+				Description: "TLS server name of the current datacenter",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"ca_certificate": {
+				Description: "PEM-encoded CA certificate of the current datacenter",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"username": {
+				Description: "CQL username of the current context",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"password": {
+				Description: "CQL password of the current context",
+				Computed:    true,
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+			"datacenters": {
+				Description: "All datacenters of the bundle, ordered by name",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "Datacenter name",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"server": {
+						Description: "Host of the datacenter",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"port": {
+						Description: "Port of the datacenter",
+						Computed:    true,
+						Type:        schema.TypeInt,
+					},
+					"node_domain": {
+						Description: "Node domain of the datacenter",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"tls_server_name": {
+						Description: "TLS server name of the datacenter",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+					"ca_certificate": {
+						Description: "PEM-encoded CA certificate of the datacenter",
+						Computed:    true,
+						Type:        schema.TypeString,
+					},
+				}},
+			},
 		},
 	}
 }
@@ -50,20 +132,128 @@ func dataSourceServerlessBundleRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("error reading connection bundle: %s", err)
 	}
 
-	var resource struct {
-		Kind string `json:"kind"`
+	b, err := parseBundle(bundle)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	if err := yaml.Unmarshal(bundle, &resource); err != nil {
-		return diag.Errorf("error parsing connection bundle: %s", err)
+	d.SetId(strconv.Itoa(int(clusterID)))
+	_ = d.Set("connection_bundle", string(bundle))
+	_ = d.Set("datacenter", b.Current.Name)
+	_ = d.Set("server", b.Current.Server)
+	_ = d.Set("port", b.Current.Port)
+	_ = d.Set("node_domain", b.Current.NodeDomain)
+	_ = d.Set("tls_server_name", b.Current.TLSServerName)
+	_ = d.Set("ca_certificate", b.Current.CACertificate)
+	_ = d.Set("username", b.Username)
+	_ = d.Set("password", b.Password)
+
+	dcs := make([]map[string]interface{}, 0, len(b.Datacenters))
+	for _, dc := range b.Datacenters {
+		dcs = append(dcs, map[string]interface{}{
+			"name":            dc.Name,
+			"server":          dc.Server,
+			"port":            dc.Port,
+			"node_domain":     dc.NodeDomain,
+			"tls_server_name": dc.TLSServerName,
+			"ca_certificate":  dc.CACertificate,
+		})
 	}
+	_ = d.Set("datacenters", dcs)
 
-	if want := "CQLConnectionConfig"; !strings.EqualFold(resource.Kind, want) {
-		return diag.Errorf("unexpected connection bundle type: got %q, want %q", resource.Kind, want)
+	return nil
+}
+
+// bundleDatacenter is a datacenter entry of a connection bundle.
+type bundleDatacenter struct {
+	Name          string
+	Server        string
+	Port          int
+	NodeDomain    string
+	TLSServerName string
+	CACertificate string
+}
+
+// parsedBundle holds the connection settings of a connection bundle.
+type parsedBundle struct {
+	Current     bundleDatacenter
+	Datacenters []bundleDatacenter
+	Username    string
+	Password    string
+}
+
+func parseBundle(bundle []byte) (*parsedBundle, error) {
+	var config model.CQLConnectionConfig
+
+	if err := yaml.Unmarshal(bundle, &config); err != nil {
+		return nil, fmt.Errorf("error parsing connection bundle: %w", err)
 	}
 
-	d.SetId(strconv.Itoa(int(clusterID)))
-	_ = d.Set("connection_bundle", string(bundle))
+	if want := model.CQLConnectionConfigKind; !strings.EqualFold(config.Kind, want) {
+		return nil, fmt.Errorf("unexpected connection bundle type: got %q, want %q", config.Kind, want)
+	}
 
-	return nil
+	var b parsedBundle
+	for name, dc := range config.Datacenters {
+		if dc == nil {
+			continue
+		}
+
+		host, port, err := splitServer(dc.Server)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing server of %q datacenter: %w", name, err)
+		}
+
+		b.Datacenters = append(b.Datacenters, bundleDatacenter{
+			Name:          name,
+			Server:        host,
+			Port:          port,
+			NodeDomain:    dc.NodeDomain,
+			TLSServerName: dc.TLSServerName,
+			CACertificate: string(dc.CertificateAuthorityData),
+		})
+	}
+
+	sort.Slice(b.Datacenters, func(i, j int) bool {
+		return b.Datacenters[i].Name < b.Datacenters[j].Name
+	})
+
+	current := config.Contexts[config.CurrentContext]
+	if current == nil {
+		return nil, fmt.Errorf("error reading current context %q: not found", config.CurrentContext)
+	}
+
+	for _, dc := range b.Datacenters {
+		if dc.Name == current.DatacenterName {
+			b.Current = dc
+		}
+	}
+	if b.Current.Name == "" {
+		return nil, fmt.Errorf("error reading %q datacenter: not found", current.DatacenterName)
+	}
+
+	if auth := config.AuthInfos[current.AuthInfoName]; auth != nil {
+		b.Username, b.Password = auth.Username, auth.Password
+	}
+
+	return &b, nil
+}
+
+// splitServer splits the host:port server address; the port is optional.
+func splitServer(server string) (string, int, error) {
+	if !strings.Contains(server, ":") {
+		return server, 0, nil
+	}
+
+	host, port, err := net.SplitHostPort(server)
+	if err != nil {
+		return "", 0, err
+	}
+
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid port %q", port)
+	}
+
+	return host, p, nil
 }
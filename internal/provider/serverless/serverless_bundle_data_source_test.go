@@ -0,0 +1,86 @@
+package serverless
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBundle(t *testing.T) {
+	t.Parallel()
+
+	ca := "-----BEGIN CERTIFICATE-----\n...\n-----END CERTIFICATE-----\n"
+
+	bundle := `kind: CQLConnectionConfig
+apiVersion: cqlclient.scylla.scylladb.com/v1alpha1
+datacenters:
+  us-east-1:
+    certificateAuthorityData: ` + base64.StdEncoding.EncodeToString([]byte(ca)) + `
+    server: sni.example.scylla.com:443
+    nodeDomain: cql.example.scylla.com
+    tlsServerName: sni.example.scylla.com
+  eu-west-1:
+    server: sni.eu.example.scylla.com
+    nodeDomain: cql.eu.example.scylla.com
+authInfos:
+  admin:
+    username: scylla
+    password: secret
+contexts:
+  default:
+    datacenterName: us-east-1
+    authInfoName: admin
+currentContext: default
+`
+
+	b, err := parseBundle([]byte(bundle))
+	require.NoError(t, err)
+
+	require.Equal(t, "scylla", b.Username)
+	require.Equal(t, "secret", b.Password)
+	require.Equal(t, bundleDatacenter{
+		Name:          "us-east-1",
+		Server:        "sni.example.scylla.com",
+		Port:          443,
+		NodeDomain:    "cql.example.scylla.com",
+		TLSServerName: "sni.example.scylla.com",
+		CACertificate: ca,
+	}, b.Current)
+	require.Len(t, b.Datacenters, 2)
+	require.Equal(t, "eu-west-1", b.Datacenters[0].Name)
+	require.Equal(t, "sni.eu.example.scylla.com", b.Datacenters[0].Server)
+	require.Zero(t, b.Datacenters[0].Port)
+}
+
+func TestParseBundleErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		bundle string
+		err    string
+	}{
+		{"kind", "kind: Secret\n", `unexpected connection bundle type: got "Secret", want "CQLConnectionConfig"`},
+		{"context", "kind: CQLConnectionConfig\ncurrentContext: default\n", `error reading current context "default": not found`},
+		{
+			"datacenter",
+			"kind: CQLConnectionConfig\ncontexts:\n  default:\n    datacenterName: dc1\ncurrentContext: default\n",
+			`error reading "dc1" datacenter: not found`,
+		},
+		{
+			"port",
+			"kind: CQLConnectionConfig\ndatacenters:\n  dc1:\n    server: host:https\n",
+			`error parsing server of "dc1" datacenter: invalid port "https"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseBundle([]byte(tt.bundle))
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
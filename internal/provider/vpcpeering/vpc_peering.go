@@ -2,6 +2,7 @@ package vpcpeering
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
@@ -10,7 +11,9 @@ import (
 	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -20,6 +23,14 @@ const (
 	vpcPeeringRetryMinTimeout = 3 * time.Second
 )
 
+// vpcPeeringWaitStatuses are the statuses wait_for_status accepts: the ones
+// a peering stays in until someone acts on it.
+var vpcPeeringWaitStatuses = []string{
+	model.VPCPeeringPendingAcceptance,
+	model.VPCPeeringInactive,
+	model.VPCPeeringActive,
+}
+
 func ResourceVPCPeering() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceVPCPeeringCreate,
@@ -28,7 +39,24 @@ func ResourceVPCPeering() *schema.Resource {
 		DeleteContext: resourceVPCPeeringDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceVPCPeeringImport,
+		},
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"cluster_id": {
+						Description:       "Cluster ID",
+						Type:              schema.TypeInt,
+						RequiredForImport: true,
+					},
+					"connection_id": {
+						Description:       "Cloud provider's peering connection ID",
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+					},
+				}
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -37,6 +65,8 @@ func ResourceVPCPeering() *schema.Resource {
 			Delete: schema.DefaultTimeout(vpcPeeringDeleteTimeout),
 		},
 
+		CustomizeDiff: resourceVPCPeeringCustomizeDiff,
+
 		SchemaVersion: 1,
 
 		StateUpgraders: []schema.StateUpgrader{
@@ -66,10 +96,13 @@ func ResourceVPCPeering() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			"peer_cidr_blocks": {
-				Description: "Peer VPC CIDR block list",
-				Optional:    true,
-				ForceNew:    true,
-				Type:        schema.TypeList,
+				Description: "Peer VPC CIDR block list. Required for AWS. On GCP it defaults to the subnet " +
+					"range of an auto mode VPC network in `peer_region`; custom mode networks must list " +
+					"their ranges, any number of them.",
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Type:     schema.TypeList,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -87,16 +120,15 @@ func ResourceVPCPeering() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			"allow_cql": {
-				Description: "Whether to allow CQL traffic",
-				Optional:    true,
-				Type:        schema.TypeBool,
-				// NOTE(rjeczalik): ForceNew is commented out here, otherwise
-				// internal provider validate fails due to all the attrs
-				// being ForceNew; Scylla Cloud API does not allow for
-				// updating existing vpc peerings, thus the update implementation
-				// always returns a non-nil error.
-				// ForceNew:    true,
-				Default: true,
+				Description: "Whether to allow CQL traffic. The API cannot change it on an existing peering, " +
+					"so changing it replaces the peering: the old peering is deleted before the new one is " +
+					"created, which interrupts traffic until the peer accepts the new one. A peer VPC cannot be " +
+					"peered twice (API error 041103), so the replacement cannot be created first, e.g. with " +
+					"`create_before_destroy`.",
+				Optional: true,
+				ForceNew: true,
+				Type:     schema.TypeBool,
+				Default:  true,
 			},
 			"vpc_peering_id": {
 				Description: "Cluster VPC Peering ID",
@@ -113,13 +145,218 @@ func ResourceVPCPeering() *schema.Resource {
 				Computed:    true,
 				Type:        schema.TypeString,
 			},
+			"wait_for_status": {
+				Description: "Status to wait for after the peering is created: PENDING_ACCEPTANCE, INACTIVE (GCP) " +
+					"or ACTIVE. By default the resource does not wait. A peering becomes ACTIVE only after the peer side " +
+					"accepts it, so waiting for ACTIVE must not be combined with an accepter that depends on " +
+					"this resource in the same apply.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(vpcPeeringWaitStatuses, true),
+			},
+			"status": {
+				Description: "VPC peering status",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"expires_at": {
+				Description: "Time at which a peering request that was not accepted expires",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"scylla_account_id": {
+				Description: "(AWS) ID of the ScyllaDB Cloud account that owns the cluster VPC and requests the peering. " +
+					"Empty for BYOA clusters, where the cluster runs in your own account.",
+				Computed: true,
+				Type:     schema.TypeString,
+			},
+			"scylla_project_id": {
+				Description: "(GCP) ID of the project that owns the cluster VPC network",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"scylla_network_name": {
+				Description: "(GCP) Name of the cluster VPC network",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"recreate_when_failed": {
+				Description: "What to do when the peering is found failed, rejected or expired during refresh. " +
+					"If true, it is removed from the state so that Terraform creates a new one, which first deletes " +
+					"the failed peerings of the cluster to the same peer VPC; otherwise a warning is reported and " +
+					"the peering is kept in the state.",
+				Optional: true,
+				Default:  false,
+				Type:     schema.TypeBool,
+			},
+			"scylla_cidr_block": {
+				Description: "CIDR block of the cluster datacenter network, to route peer traffic to",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
 		},
 	}
 }
 
+// resourceVPCPeeringCustomizeDiff rejects CIDR blocks that overlap where the
+// backend would reject them only deep into the apply.
+func resourceVPCPeeringCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	c, ok := meta.(*scylla.Client)
+	if !ok || c == nil {
+		return nil
+	}
+
+	if err := validatePeerCIDRBlocks(ctx, d, c); err != nil {
+		return err
+	}
+
+	return validatePeeredClusters(ctx, d, c)
+}
+
+// validatePeerCIDRBlocks rejects peer CIDR blocks that overlap each other or
+// the networks already routed through the cluster: its datacenter networks,
+// its other peerings and its cluster connections.
+func validatePeerCIDRBlocks(ctx context.Context, d *schema.ResourceDiff, c *scylla.Client) error {
+	if d.Id() != "" && !d.HasChange("peer_cidr_blocks") {
+		return nil
+	}
+
+	// A default GCP block is omitted from the configuration and the list is
+	// unknown until the peering is created.
+	if raw := d.GetRawConfig(); raw.IsNull() || !d.NewValueKnown("cluster_id") ||
+		!raw.GetAttr("peer_cidr_blocks").IsWhollyKnown() || raw.GetAttr("peer_cidr_blocks").IsNull() {
+		return nil
+	}
+
+	blocks, err := schemautils.ConvertListToConcrete[string](d.Get("peer_cidr_blocks"))
+	if err != nil {
+		return err
+	}
+
+	requested := make([]schemautils.CIDRSource, 0, len(blocks))
+	for _, cidr := range blocks {
+		requested = append(requested, schemautils.CIDRSource{CIDR: cidr, Source: "peer_cidr_blocks"})
+	}
+
+	clusterID := int64(d.Get("cluster_id").(int))
+
+	existing, err := schemautils.ClusterCIDRSources(ctx, c, clusterID, d.Id(), 0)
+	if err != nil {
+		return err
+	}
+
+	overlaps, err := schemautils.FindCIDROverlaps(requested, existing)
+	if err != nil {
+		return err
+	}
+
+	return schemautils.CIDROverlapError(overlaps)
+}
+
+// validatePeeredClusters rejects a peering whose datacenter network overlaps
+// that of another cluster of the account already peered with the same peer
+// VPC, which could not route to both. Peering a single VPC with several
+// clusters is fine otherwise.
+func validatePeeredClusters(ctx context.Context, d *schema.ResourceDiff, c *scylla.Client) error {
+	if d.Id() != "" && !d.HasChange("peer_vpc_id") && !d.HasChange("datacenter") && !d.HasChange("cluster_id") {
+		return nil
+	}
+
+	if !d.NewValueKnown("cluster_id") || !d.NewValueKnown("datacenter") || !d.NewValueKnown("peer_vpc_id") {
+		return nil
+	}
+
+	var (
+		clusterID = int64(d.Get("cluster_id").(int))
+		dcName    = d.Get("datacenter").(string)
+		peerVPC   = d.Get("peer_vpc_id").(string)
+	)
+
+	dcs, err := c.ListDataCenters(ctx, clusterID)
+	if err != nil {
+		if scylla.IsNotFound(err) || scylla.IsDeletedErr(err) || scylla.IsClusterDeletedErr(err) {
+			return nil // reported by the apply
+		}
+		return fmt.Errorf("error reading datacenters of cluster ID=%d: %w", clusterID, err)
+	}
+
+	dc := findDatacenter(dcs, dcName)
+	if dc == nil || dc.CIDRBlock == "" {
+		return nil // reported by the apply
+	}
+
+	others, err := schemautils.PeeredClusterCIDRSources(ctx, c, peerVPC, clusterID)
+	if err != nil {
+		return err
+	}
+
+	requested := []schemautils.CIDRSource{{
+		CIDR:   dc.CIDRBlock,
+		Source: fmt.Sprintf("cluster %d datacenter %s", clusterID, dc.Name),
+	}}
+
+	overlaps, err := schemautils.FindCIDROverlaps(requested, others)
+	if err != nil {
+		return err
+	}
+
+	return schemautils.CIDROverlapError(overlaps)
+}
+
 func resourceVPCPeeringCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
-		c                        = meta.(*scylla.Client)
+		c         = meta.(*scylla.Client)
+		clusterID = d.Get("cluster_id").(int)
+	)
+
+	r, dc, p, err := expandVPCPeeringRequest(ctx, c, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// A failed predecessor still occupies the peer network, so the new
+	// peering would be rejected as already peered (041103).
+	if d.Get("recreate_when_failed").(bool) {
+		if err := deleteFailedVPCPeerings(ctx, c, int64(clusterID), r.VPC); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	vp, err := c.CreateClusterVPCPeering(ctx, int64(clusterID), r)
+	if err != nil {
+		if scylla.IsAlreadyPeeredErr(err) {
+			return diag.Errorf("error creating vpc peering: %s; the peer VPC is still peered with the cluster, "+
+				"delete that peering first (a replacement cannot use create_before_destroy)", err)
+		}
+		return diag.Errorf("error creating vpc peering: %s", err)
+	}
+
+	d.SetId(vp.ExternalID)
+
+	_ = d.Set("vpc_peering_id", vp.ID)
+	_ = d.Set("connection_id", vp.ExternalID)
+	_ = d.Set("network_link", vp.NetworkLink())
+
+	if err := setVPCPeeringIdentity(d, int64(clusterID), vp.ExternalID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if status := d.Get("wait_for_status").(string); status != "" {
+		vp, err = waitForVPCPeering(ctx, c, int64(clusterID), vp.ID, status, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	setVPCPeeringKVs(d, vp, dc, p)
+
+	return nil
+}
+
+// expandVPCPeeringRequest builds the request creating the configured peering,
+// together with the cluster datacenter and cloud provider it belongs to.
+func expandVPCPeeringRequest(ctx context.Context, c *scylla.Client, d *schema.ResourceData) (*model.VPCPeeringRequest, *model.Datacenter, *scylla.CloudProvider, error) {
+	var (
 		pr                       = d.Get("peer_region").(string)
 		dcName                   = d.Get("datacenter").(string)
 		cidrBlocks, cidrBlocksOK = d.GetOk("peer_cidr_blocks")
@@ -129,94 +366,169 @@ func resourceVPCPeeringCreate(ctx context.Context, d *schema.ResourceData, meta
 			Owner:    d.Get("peer_account_id").(string),
 		}
 		clusterID = d.Get("cluster_id").(int)
-		p         *scylla.CloudProvider
-		dc        *model.Datacenter
 	)
 
 	dcs, err := c.ListDataCenters(ctx, int64(clusterID))
 	if err != nil {
-		return diag.Errorf("error reading clusters: %s", err)
-	}
-
-	for i := range dcs {
-		dc = &dcs[i]
-
-		if strings.EqualFold(dc.Name, dcName) {
-			r.DatacenterID = dc.ID
-			p = c.Meta.ProviderByID(dc.CloudProviderID)
-			break
-		}
+		return nil, nil, nil, fmt.Errorf("error reading clusters: %w", err)
 	}
 
+	dc := findDatacenter(dcs, dcName)
 	if dc == nil {
-		return diag.Errorf("unable to find %q datacenter", dcName)
+		return nil, nil, nil, fmt.Errorf("unable to find %q datacenter", dcName)
 	}
 
+	r.DatacenterID = dc.ID
+
+	p := c.Meta.ProviderByID(dc.CloudProviderID)
 	if p == nil {
-		return diag.Errorf("unable to find cloud provider with id=%d", dc.CloudProviderID)
+		return nil, nil, nil, fmt.Errorf("unable to find cloud provider with id=%d", dc.CloudProviderID)
 	}
 
 	region := p.RegionByName(pr)
 	if region == nil {
-		return diag.Errorf("unrecognized region %q", pr)
+		return nil, nil, nil, fmt.Errorf("unrecognized region %q", pr)
 	}
 
 	r.RegionID = region.ID
 	if !cidrBlocksOK {
 		if !strings.EqualFold(p.CloudProvider.Name, "GCP") {
-			return diag.Errorf(`"peer_cidr_blocks" is required for %q cloud`, p.CloudProvider.Name)
+			return nil, nil, nil, fmt.Errorf(`"peer_cidr_blocks" is required for %q cloud`, p.CloudProvider.Name)
 		}
 
-		cidr, ok := c.Meta.GCPBlocks[pr]
+		// Only auto mode VPC networks have a well-known subnet range per
+		// region; custom mode networks must list their ranges explicitly.
+		cidr, ok := c.Meta.GCPBlock(pr)
 		if !ok {
-			return diag.Errorf("no default peer CIDR block found for %q region", pr)
+			return nil, nil, nil, fmt.Errorf(
+				`no default peer CIDR block found for %q region; set "peer_cidr_blocks", `+
+					`or add the region to the provider "gcp_peer_cidr_blocks" attribute`,
+				pr,
+			)
 		}
 
 		cidrBlocks = []any{cidr}
-	} else if strings.EqualFold(p.CloudProvider.Name, "GCP") {
-		cidr, ok := c.Meta.GCPBlocks[pr]
-		if !ok {
-			return diag.Errorf("no default peer CIDR block found for %q region", pr)
-		}
-
-		if blocks := cidrBlocks.([]any); len(blocks) > 1 {
-			if blocks[0].(string) == cidr {
-				return diag.Errorf(`omit "peer_cidr_blocks" attribute for default GCP cidr blocks`)
-			}
-		}
 	}
 
 	if len(cidrBlocks.([]any)) == 0 {
-		return diag.Errorf(`"peer_cidr_blocks" cannot be empty`)
+		return nil, nil, nil, fmt.Errorf(`"peer_cidr_blocks" cannot be empty`)
 	}
 
 	cidrList, err := schemautils.ConvertListToConcrete[string](cidrBlocks)
 	if err != nil {
-		return diag.Errorf(`"peer_cidr_blocks" must be a list of strings`)
+		return nil, nil, nil, fmt.Errorf(`"peer_cidr_blocks" must be a list of strings`)
 	}
 
+	_ = d.Set("peer_cidr_blocks", cidrList)
+
 	// TODO: Use appropriate API field type that supports multiple CIDR blocks
 	// once that is done done there's no need to join the CIDR blocks into a string.
 	r.CidrBlock = strings.Join(cidrList, ",")
 
-	if r.DatacenterID == 0 {
-		return diag.Errorf("unrecognized datacenter %q", dcName)
-	}
+	return r, dc, p, nil
+}
 
-	vp, err := c.CreateClusterVPCPeering(ctx, int64(clusterID), r)
+// deleteFailedVPCPeerings deletes the peerings of the cluster to the given peer
+// VPC that can no longer become active.
+func deleteFailedVPCPeerings(ctx context.Context, c *scylla.Client, clusterID int64, vpcID string) error {
+	vps, err := c.ListClusterVPCPeerings(ctx, clusterID)
 	if err != nil {
-		return diag.Errorf("error creating vpc peering: %s", err)
+		return fmt.Errorf("error reading vpc peerings of cluster ID=%d: %w", clusterID, err)
 	}
 
-	d.SetId(vp.ExternalID)
+	now := time.Now()
+	for i := range vps {
+		vp := &vps[i]
 
-	_ = d.Set("vpc_peering_id", vp.ID)
-	_ = d.Set("connection_id", vp.ExternalID)
-	_ = d.Set("network_link", vp.NetworkLink())
+		if !strings.EqualFold(vp.VPCID, vpcID) || strings.EqualFold(vp.Status, model.VPCPeeringDeleted) ||
+			vpcPeeringFailure(vp, now) == "" {
+			continue
+		}
+
+		if err := c.DeleteClusterVPCPeering(ctx, clusterID, vp.ID); err != nil && !scylla.IsNotFound(err) && !scylla.IsDeletedErr(err) {
+			return fmt.Errorf("error deleting failed vpc peering %q: %w", vp.ExternalID, err)
+		}
+	}
+
+	return nil
+}
 
+// findDatacenter returns the datacenter with the given name, or nil.
+func findDatacenter(dcs []model.Datacenter, name string) *model.Datacenter {
+	for i := range dcs {
+		if strings.EqualFold(dcs[i].Name, name) {
+			return &dcs[i]
+		}
+	}
 	return nil
 }
 
+// waitForVPCPeering polls the peering until it reports the target status.
+// It gives up early when the peering reaches a status it can never leave.
+func waitForVPCPeering(ctx context.Context, c *scylla.Client, clusterID, peerID int64, target string, timeout time.Duration) (*model.VPCPeering, error) {
+	const waiting = "WAITING"
+
+	refresh := func() (interface{}, string, error) {
+		vp, err := c.GetClusterVPCPeering(ctx, clusterID, peerID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		switch {
+		case strings.EqualFold(vp.Status, target):
+			return vp, target, nil
+		case vp.Failed():
+			return nil, "", fmt.Errorf("vpc peering %d reached terminal status %q", peerID, vp.Status)
+		default:
+			return vp, waiting, nil
+		}
+	}
+
+	// Check immediately before waiting for the first poll.
+	vp, status, err := refresh()
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for vpc peering %d to become %q: %w", peerID, target, err)
+	}
+	if status == target {
+		return vp.(*model.VPCPeering), nil
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{waiting},
+		Target:     []string{target},
+		Refresh:    refresh,
+		Delay:      vpcPeeringRetryDelay,
+		MinTimeout: vpcPeeringRetryMinTimeout,
+		Timeout:    timeout,
+	}
+
+	vp, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for vpc peering %d to become %q: %w", peerID, target, err)
+	}
+
+	return vp.(*model.VPCPeering), nil
+}
+
+// setVPCPeeringKVs sets the attributes describing the peering and the cluster
+// side of it, which the peer needs in order to accept the peering and route
+// traffic back.
+func setVPCPeeringKVs(d *schema.ResourceData, vp *model.VPCPeering, dc *model.Datacenter, p *scylla.CloudProvider) {
+	var scyllaAccountID string
+
+	// BYOA credential IDs start at 1000, see the cluster resource.
+	if strings.EqualFold(p.CloudProvider.Name, "AWS") && dc.AccountCloudProviderCredentialID < 1000 {
+		scyllaAccountID = p.CloudProvider.RootAccountID
+	}
+
+	_ = d.Set("status", vp.Status)
+	_ = d.Set("expires_at", vp.ExpiresAt)
+	_ = d.Set("scylla_account_id", scyllaAccountID)
+	_ = d.Set("scylla_project_id", vp.ProjectID)
+	_ = d.Set("scylla_network_name", vp.NetworkName)
+	_ = d.Set("scylla_cidr_block", dc.CIDRBlock)
+}
+
 func resourceVPCPeeringRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		c          = meta.(*scylla.Client)
@@ -272,7 +584,21 @@ lookup:
 		}
 	}
 
-	_ = d.Set("datacenter", cluster.Datacenter.Name)
+	// A peering belongs to a single datacenter, which the API does not
+	// report; use the configured one and fall back to the primary
+	// datacenter, e.g. on import.
+	dc := cluster.Datacenter
+	if name := d.Get("datacenter").(string); name != "" {
+		dcs, err := c.ListDataCenters(ctx, cluster.ID)
+		if err != nil {
+			return diag.Errorf("error reading datacenters of cluster ID=%d: %s", cluster.ID, err)
+		}
+		if found := findDatacenter(dcs, name); found != nil {
+			dc = found
+		}
+	}
+
+	_ = d.Set("datacenter", dc.Name)
 	_ = d.Set("peer_vpc_id", vpcPeering.VPCID)
 	_ = d.Set("peer_account_id", vpcPeering.OwnerID)
 	_ = d.Set("vpc_peering_id", vpcPeering.ID)
@@ -282,10 +608,85 @@ lookup:
 	_ = d.Set("allow_cql", vpcPeering.AllowCQL)
 	_ = d.Set("peer_cidr_blocks", vpcPeering.CIDRList)
 
+	setVPCPeeringKVs(d, vpcPeering, dc, p)
+
+	if err := setVPCPeeringIdentity(d, cluster.ID, vpcPeering.ExternalID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if reason := vpcPeeringFailure(vpcPeering, time.Now()); reason != "" {
+		if d.Get("recreate_when_failed").(bool) {
+			// Create deletes the failed peering before requesting its successor.
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("VPC peering %q %s", connID, reason),
+			Detail: "The peering does not carry any traffic. Set \"recreate_when_failed = true\" to have " +
+				"Terraform create a new one, or replace it with \"terraform apply -replace\".",
+		}}
+	}
+
 	return nil
 }
 
+// resourceVPCPeeringImport imports a peering by its connection ID or, when
+// imported with an identity, by the connection_id identity attribute.
+func resourceVPCPeeringImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if d.Id() == "" {
+		identity, err := d.Identity()
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(identity.Get("connection_id").(string))
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func setVPCPeeringIdentity(d *schema.ResourceData, clusterID int64, connectionID string) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+	if err := identity.Set("cluster_id", clusterID); err != nil {
+		return err
+	}
+	return identity.Set("connection_id", connectionID)
+}
+
+// vpcPeeringFailure describes why the peering can no longer become active,
+// or returns an empty string if it still can. A request that is not accepted
+// yet and whose expiration time has passed counts as expired even before the
+// API says so.
+func vpcPeeringFailure(vp *model.VPCPeering, now time.Time) string {
+	if vp.Failed() {
+		return "is " + strings.ToLower(vp.Status)
+	}
+
+	// Only a request can expire; once accepted, the peering is past it.
+	requested := strings.EqualFold(vp.Status, model.VPCPeeringInitiatingRequest) ||
+		strings.EqualFold(vp.Status, model.VPCPeeringPendingAcceptance)
+	if !requested || vp.ExpiresAt == "" {
+		return ""
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, vp.ExpiresAt)
+	if err != nil || now.Before(expiresAt) {
+		return ""
+	}
+
+	return "expired at " + vp.ExpiresAt + " without being accepted"
+}
+
 func resourceVPCPeeringUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// wait_for_status and recreate_when_failed only affect how the provider
+	// manages the peering, not the peering itself.
+	if !d.HasChangesExcept("wait_for_status", "recreate_when_failed") {
+		return nil
+	}
+
 	return diag.Errorf(`updating "scylla_vpc_peering" resource is not supported`)
 }
 
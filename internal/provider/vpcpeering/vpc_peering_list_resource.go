@@ -0,0 +1,207 @@
+package vpcpeering
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/cluster"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/metadata"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/provider/providerdata"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+)
+
+var (
+	_ list.ListResourceWithConfigure    = (*vpcPeeringListResource)(nil)
+	_ list.ListResourceWithRawV5Schemas = (*vpcPeeringListResource)(nil)
+)
+
+// vpcPeeringListResource lists the VPC peerings of one or all clusters
+// of the account for "terraform query".
+type vpcPeeringListResource struct {
+	client *scylla.Client
+}
+
+type vpcPeeringListModel struct {
+	ClusterID types.Int64 `tfsdk:"cluster_id"`
+}
+
+func NewVPCPeeringListResource() list.ListResource {
+	return &vpcPeeringListResource{}
+}
+
+func (r *vpcPeeringListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_peering"
+}
+
+func (r *vpcPeeringListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	res := ResourceVPCPeering()
+	resp.ProtoV5Schema = res.ProtoSchema(ctx)()
+	resp.ProtoV5IdentitySchema = res.ProtoIdentitySchema(ctx)()
+}
+
+func (r *vpcPeeringListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the VPC peerings of the clusters of the account.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.Int64Attribute{
+				Description: "Only list the peerings of this cluster. Defaults to all clusters that are not deleted.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *vpcPeeringListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var diags diag.Diagnostics
+	r.client, diags = providerdata.Client(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *vpcPeeringListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var m vpcPeeringListModel
+
+	if diags := req.Config.Get(ctx, &m); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if r.client == nil {
+		stream.Results = list.ListResultsStreamDiagnostics(providerdata.NotConfigured())
+		return
+	}
+
+	clusterIDs := []int64{m.ClusterID.ValueInt64()}
+	if m.ClusterID.IsNull() {
+		var err error
+		if clusterIDs, err = cluster.ListClusterIDs(ctx, r.client); err != nil {
+			var diags diag.Diagnostics
+			diags.AddError("Error reading cluster list", err.Error())
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	// The resource objects carry the datacenter of the peering, which the API
+	// does not report; like Read, use the primary datacenter of the cluster.
+	var (
+		meta     *scylla.Cloudmeta
+		clusters map[int64]*model.Cluster
+	)
+	if req.IncludeResource {
+		var err error
+		if meta, clusters, err = listClusters(ctx, r.client); err != nil {
+			var diags diag.Diagnostics
+			diags.AddError("Error reading cluster list", err.Error())
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var n int64
+		for _, clusterID := range clusterIDs {
+			peerings, err := r.client.ListClusterVPCPeerings(ctx, clusterID)
+			if err != nil {
+				var result list.ListResult
+				result.Diagnostics.AddError("Error reading VPC peerings",
+					fmt.Sprintf("error reading vpc peerings for cluster ID=%d: %s", clusterID, err))
+				push(result)
+				return
+			}
+
+			for i := range peerings {
+				vp := &peerings[i]
+
+				// The peering is managed by its connection ID, which is only
+				// known once the cloud provider accepted the request.
+				if vp.ExternalID == "" || strings.EqualFold(vp.Status, "DELETED") {
+					continue
+				}
+
+				if req.Limit > 0 && n >= req.Limit {
+					return
+				}
+				n++
+
+				if !push(vpcPeeringListResult(ctx, req, meta, clusters[clusterID], clusterID, vp)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// listClusters returns the metadata and the clusters of the account by ID.
+func listClusters(ctx context.Context, c *scylla.Client) (*scylla.Cloudmeta, map[int64]*model.Cluster, error) {
+	meta, err := metadata.Cloudmeta(ctx, c)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading metadata: %w", err)
+	}
+
+	clusters, err := c.ListClusters(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading cluster list: %w", err)
+	}
+
+	byID := make(map[int64]*model.Cluster, len(clusters))
+	for i := range clusters {
+		byID[clusters[i].ID] = &clusters[i]
+	}
+
+	return meta, byID, nil
+}
+
+func vpcPeeringListResult(ctx context.Context, req list.ListRequest, meta *scylla.Cloudmeta, c *model.Cluster, clusterID int64, vp *model.VPCPeering) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = fmt.Sprintf("%s (cluster %d)", vp.ExternalID, clusterID)
+
+	result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("cluster_id"), clusterID)...)
+	result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("connection_id"), vp.ExternalID)...)
+
+	if req.IncludeResource {
+		attrs := map[string]any{
+			"id":               vp.ExternalID,
+			"cluster_id":       clusterID,
+			"peer_vpc_id":      vp.VPCID,
+			"peer_account_id":  vp.OwnerID,
+			"peer_cidr_blocks": vp.CIDRList,
+			"allow_cql":        vp.AllowCQL,
+			"vpc_peering_id":   vp.ID,
+			"connection_id":    vp.ExternalID,
+			"network_link":     vp.NetworkLink(),
+			"status":           vp.Status,
+		}
+
+		if c != nil && c.Datacenter != nil {
+			attrs["datacenter"] = c.Datacenter.Name
+			attrs["scylla_cidr_block"] = c.Datacenter.CIDRBlock
+
+			// RegionID is always 0 for GCP peering, whose network is global;
+			// report the region of the datacenter instead.
+			regionID := vp.RegionID
+			if regionID == 0 {
+				regionID = c.Datacenter.RegionID
+			}
+			if p := meta.ProviderByID(c.CloudProviderID); p != nil {
+				if r := p.RegionByID(regionID); r != nil {
+					attrs["peer_region"] = r.ExternalID
+				}
+			}
+		}
+
+		for name, v := range attrs {
+			result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root(name), v)...)
+		}
+	}
+
+	return result
+}
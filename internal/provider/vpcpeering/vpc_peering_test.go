@@ -0,0 +1,458 @@
+package vpcpeering
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/scyllatest"
+)
+
+var (
+	testAWS = &scylla.CloudProvider{
+		CloudProvider: &model.CloudProvider{ID: 1, Name: "AWS", RootAccountID: "111111111111"},
+		CloudProviderRegions: &model.CloudProviderRegions{
+			Regions: []model.CloudProviderRegion{{ID: 11, ExternalID: "us-east-1"}},
+		},
+	}
+	testGCP = &scylla.CloudProvider{
+		CloudProvider:        &model.CloudProvider{ID: 2, Name: "GCP"},
+		CloudProviderRegions: &model.CloudProviderRegions{},
+	}
+)
+
+func newTestClient(t *testing.T, h http.HandlerFunc) *scylla.Client {
+	t.Helper()
+
+	c := scyllatest.NewClient(t, h)
+	c.Meta = &scylla.Cloudmeta{CloudProviders: []scylla.CloudProvider{*testAWS, *testGCP}}
+	return c
+}
+
+func TestVPCPeeringFailure(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		vp   model.VPCPeering
+		want string
+	}{
+		{name: "active", vp: model.VPCPeering{Status: "ACTIVE"}},
+		{name: "active past expiration", vp: model.VPCPeering{Status: "ACTIVE", ExpiresAt: "2026-01-01T00:00:00Z"}},
+		{name: "pending without expiration", vp: model.VPCPeering{Status: "PENDING_ACCEPTANCE"}},
+		{name: "pending before expiration", vp: model.VPCPeering{Status: "PENDING_ACCEPTANCE", ExpiresAt: "2026-01-03T00:00:00Z"}},
+		{name: "pending unparsable expiration", vp: model.VPCPeering{Status: "PENDING_ACCEPTANCE", ExpiresAt: "tomorrow"}},
+		{
+			name: "pending past expiration",
+			vp:   model.VPCPeering{Status: "PENDING_ACCEPTANCE", ExpiresAt: "2026-01-01T00:00:00Z"},
+			want: "expired at 2026-01-01T00:00:00Z without being accepted",
+		},
+		{
+			name: "initiating past expiration",
+			vp:   model.VPCPeering{Status: "INITIATING_REQUEST", ExpiresAt: "2026-01-01T00:00:00Z"},
+			want: "expired at 2026-01-01T00:00:00Z without being accepted",
+		},
+		{name: "provisioning past expiration", vp: model.VPCPeering{Status: "PROVISIONING", ExpiresAt: "2026-01-01T00:00:00Z"}},
+		{name: "inactive past expiration", vp: model.VPCPeering{Status: "INACTIVE", ExpiresAt: "2026-01-01T00:00:00Z"}},
+		{name: "deleting past expiration", vp: model.VPCPeering{Status: "DELETING", ExpiresAt: "2026-01-01T00:00:00Z"}},
+		{name: "expired", vp: model.VPCPeering{Status: "EXPIRED"}, want: "is expired"},
+		{name: "failed", vp: model.VPCPeering{Status: "failed"}, want: "is failed"},
+		{name: "rejected", vp: model.VPCPeering{Status: "REJECTED"}, want: "is rejected"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, vpcPeeringFailure(&tt.vp, now))
+		})
+	}
+}
+
+func TestResourceVPCPeeringSchema(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ResourceVPCPeering().InternalValidate(nil, true))
+}
+
+func TestResourceVPCPeeringImportIdentity(t *testing.T) {
+	t.Parallel()
+
+	r := ResourceVPCPeering()
+	d := schema.TestResourceDataWithIdentityRaw(t, r.SchemaMap(), r.Identity.SchemaMap(), map[string]string{
+		"cluster_id":    "42",
+		"connection_id": "pcx-1234",
+	})
+
+	ds, err := resourceVPCPeeringImport(context.Background(), d, nil)
+	require.NoError(t, err)
+	require.Len(t, ds, 1)
+	require.Equal(t, "pcx-1234", ds[0].Id())
+}
+
+func TestResourceVPCPeeringWaitForStatusValidation(t *testing.T) {
+	t.Parallel()
+
+	validate := ResourceVPCPeering().Schema["wait_for_status"].ValidateFunc
+
+	for _, status := range []string{"PENDING_ACCEPTANCE", "ACTIVE", "active", "INACTIVE"} {
+		_, errs := validate(status, "wait_for_status")
+		require.Empty(t, errs, status)
+	}
+
+	for _, status := range []string{"ACTIV", "FAILED", "DELETED", ""} {
+		_, errs := validate(status, "wait_for_status")
+		require.NotEmpty(t, errs, status)
+	}
+}
+
+func TestSetVPCPeeringKVs(t *testing.T) {
+	t.Parallel()
+
+	vp := &model.VPCPeering{
+		Status:      "PENDING_ACCEPTANCE",
+		ExpiresAt:   "2026-01-01T00:00:00Z",
+		ProjectID:   "scylla-project",
+		NetworkName: "scylla-network",
+	}
+
+	tests := []struct {
+		name          string
+		dc            *model.Datacenter
+		p             *scylla.CloudProvider
+		wantAccountID string
+	}{
+		{
+			name:          "aws",
+			dc:            &model.Datacenter{CIDRBlock: "172.31.0.0/16", AccountCloudProviderCredentialID: 3},
+			p:             testAWS,
+			wantAccountID: "111111111111",
+		},
+		{
+			name: "aws byoa",
+			dc:   &model.Datacenter{CIDRBlock: "172.31.0.0/16", AccountCloudProviderCredentialID: 1001},
+			p:    testAWS,
+		},
+		{
+			name: "gcp",
+			dc:   &model.Datacenter{CIDRBlock: "172.31.0.0/16", AccountCloudProviderCredentialID: 3},
+			p:    testGCP,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := ResourceVPCPeering().TestResourceData()
+			setVPCPeeringKVs(d, vp, tt.dc, tt.p)
+
+			require.Equal(t, "PENDING_ACCEPTANCE", d.Get("status"))
+			require.Equal(t, "2026-01-01T00:00:00Z", d.Get("expires_at"))
+			require.Equal(t, tt.wantAccountID, d.Get("scylla_account_id"))
+			require.Equal(t, "scylla-project", d.Get("scylla_project_id"))
+			require.Equal(t, "scylla-network", d.Get("scylla_network_name"))
+			require.Equal(t, "172.31.0.0/16", d.Get("scylla_cidr_block"))
+		})
+	}
+}
+
+func TestWaitForVPCPeering(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/account/7/cluster/42/network/vpc/peer/5":
+			_, _ = w.Write([]byte(`{"data":{"id":5,"externalId":"pcx-1","status":"ACTIVE"}}`))
+		case "/account/7/cluster/42/network/vpc/peer/6":
+			_, _ = w.Write([]byte(`{"data":{"id":6,"externalId":"pcx-2","status":"REJECTED"}}`))
+		default:
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+	})
+
+	vp, err := waitForVPCPeering(t.Context(), c, 42, 5, "active", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, "pcx-1", vp.ExternalID)
+
+	_, err = waitForVPCPeering(t.Context(), c, 42, 6, "ACTIVE", time.Minute)
+	require.ErrorContains(t, err, `vpc peering 6 reached terminal status "REJECTED"`)
+}
+
+func TestResourceVPCPeeringReadDatacenter(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/account/7/clusters":
+			_, _ = w.Write([]byte(`{"data":{"clusters":[{"id":42}]}}`))
+		case "/account/7/cluster/42":
+			_, _ = w.Write([]byte(`{"data":{"cluster":{
+				"id":42,"cloudProviderId":1,
+				"dc":{"id":1,"name":"AWS_US_EAST_1","cidrBlock":"172.31.0.0/16"},
+				"dataCenters":[{"id":1,"name":"AWS_US_EAST_1","cidrBlock":"172.31.0.0/16"}],
+				"vpcPeeringList":[{"id":5,"externalId":"pcx-1","vpcId":"vpc-1","ownerId":"123","regionId":11,"cidrList":["10.0.0.0/16"],"status":"ACTIVE"}]
+			}}}`))
+		case "/account/7/cluster/42/dc/1":
+			_, _ = w.Write([]byte(`{"data":{"id":1}}`))
+		case "/account/7/cluster/42/dcs":
+			_, _ = w.Write([]byte(`{"data":{"dataCenters":[
+				{"id":1,"name":"AWS_US_EAST_1","cidrBlock":"172.31.0.0/16"},
+				{"id":2,"name":"AWS_EU_WEST_1","cidrBlock":"172.32.0.0/16"}
+			]}}`))
+		default:
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+	})
+
+	for dc, wantCIDR := range map[string]string{
+		"AWS_EU_WEST_1": "172.32.0.0/16",
+		"":              "172.31.0.0/16", // imported
+	} {
+		d := ResourceVPCPeering().TestResourceData()
+		d.SetId("pcx-1")
+		require.NoError(t, d.Set("datacenter", dc))
+
+		require.Empty(t, resourceVPCPeeringRead(t.Context(), d, c))
+		require.Equal(t, wantCIDR, d.Get("scylla_cidr_block"), dc)
+		require.Equal(t, "us-east-1", d.Get("peer_region"), dc)
+	}
+}
+
+func TestResourceVPCPeeringReadFailed(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/account/7/clusters":
+			_, _ = w.Write([]byte(`{"data":{"clusters":[{"id":42}]}}`))
+		case "/account/7/cluster/42":
+			_, _ = w.Write([]byte(`{"data":{"cluster":{
+				"id":42,"cloudProviderId":1,
+				"dc":{"id":1,"name":"AWS_US_EAST_1"},
+				"dataCenters":[{"id":1,"name":"AWS_US_EAST_1"}],
+				"vpcPeeringList":[
+					{"id":5,"externalId":"pcx-1","status":"FAILED"},
+					{"id":6,"externalId":"pcx-2","status":"DELETED"}
+				]
+			}}}`))
+		case "/account/7/cluster/42/dc/1":
+			_, _ = w.Write([]byte(`{"data":{"id":1}}`))
+		case "/account/7/cluster/42/dcs":
+			_, _ = w.Write([]byte(`{"data":{"dataCenters":[{"id":1,"name":"AWS_US_EAST_1"}]}}`))
+		default:
+			// Refreshing must not change anything, e.g. delete the peering.
+			t.Errorf("unexpected request %s %q", r.Method, r.URL.Path)
+		}
+	})
+
+	for _, recreate := range []bool{true, false} {
+		for _, connID := range []string{"pcx-1", "pcx-2"} {
+			d := ResourceVPCPeering().TestResourceData()
+			d.SetId(connID)
+			require.NoError(t, d.Set("datacenter", "AWS_US_EAST_1"))
+			require.NoError(t, d.Set("recreate_when_failed", recreate))
+
+			diags := resourceVPCPeeringRead(t.Context(), d, c)
+			require.False(t, diags.HasError(), "%s: %v", connID, diags)
+
+			if recreate {
+				require.Empty(t, diags, connID)
+				require.Empty(t, d.Id(), connID)
+			} else {
+				require.Len(t, diags, 1, connID)
+				require.Equal(t, connID, d.Id())
+			}
+		}
+	}
+}
+
+func TestResourceVPCPeeringCreateRecreate(t *testing.T) {
+	t.Parallel()
+
+	for _, recreate := range []bool{true, false} {
+		var deleted []string
+
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == "/account/7/cluster/42/dcs":
+				_, _ = w.Write([]byte(`{"data":{"dataCenters":[{"id":1,"name":"AWS_US_EAST_1","cloudProviderId":1}]}}`))
+			case r.Method == http.MethodGet && r.URL.Path == "/account/7/cluster/42/network/vpc/peer":
+				_, _ = w.Write([]byte(`{"data":[
+					{"id":1,"externalId":"pcx-1","vpcId":"vpc-1","status":"FAILED"},
+					{"id":2,"externalId":"pcx-2","vpcId":"VPC-1","status":"PENDING_ACCEPTANCE","expiresAt":"2020-01-01T00:00:00Z"},
+					{"id":3,"externalId":"pcx-3","vpcId":"vpc-1","status":"DELETED"},
+					{"id":4,"externalId":"pcx-4","vpcId":"vpc-2","status":"FAILED"},
+					{"id":5,"externalId":"pcx-5","vpcId":"vpc-1","status":"PROVISIONING","expiresAt":"2020-01-01T00:00:00Z"}
+				]}`))
+			case r.Method == http.MethodPost && r.URL.Path == "/account/7/cluster/42/network/vpc/peer":
+				_, _ = w.Write([]byte(`{"data":{"id":6}}`))
+			case r.Method == http.MethodGet && r.URL.Path == "/account/7/cluster/42/network/vpc/peer/6":
+				_, _ = w.Write([]byte(`{"data":{"id":6,"externalId":"pcx-6","status":"INITIATING_REQUEST"}}`))
+			case r.Method == http.MethodDelete:
+				deleted = append(deleted, r.URL.Path)
+				_, _ = w.Write([]byte(`{"data":{}}`))
+			default:
+				t.Errorf("unexpected request %s %q", r.Method, r.URL.Path)
+			}
+		})
+
+		d := ResourceVPCPeering().TestResourceData()
+		for k, v := range map[string]interface{}{
+			"cluster_id":           42,
+			"datacenter":           "AWS_US_EAST_1",
+			"peer_vpc_id":          "vpc-1",
+			"peer_region":          "us-east-1",
+			"peer_account_id":      "123",
+			"peer_cidr_blocks":     []interface{}{"10.0.0.0/16"},
+			"recreate_when_failed": recreate,
+		} {
+			require.NoError(t, d.Set(k, v))
+		}
+
+		require.Empty(t, resourceVPCPeeringCreate(t.Context(), d, c))
+		require.Equal(t, "pcx-6", d.Id())
+
+		if recreate {
+			require.Equal(t, []string{
+				"/account/7/cluster/42/network/vpc/peer/1",
+				"/account/7/cluster/42/network/vpc/peer/2",
+			}, deleted)
+		} else {
+			require.Empty(t, deleted)
+		}
+	}
+}
+
+func TestResourceVPCPeeringAllowCQLReplaces(t *testing.T) {
+	t.Parallel()
+
+	r := ResourceVPCPeering()
+	state := &terraform.InstanceState{
+		ID: "pcx-1",
+		Attributes: map[string]string{
+			"id":                   "pcx-1",
+			"cluster_id":           "42",
+			"datacenter":           "AWS_US_EAST_1",
+			"peer_vpc_id":          "vpc-1",
+			"peer_region":          "us-east-1",
+			"peer_account_id":      "123",
+			"peer_cidr_blocks.#":   "1",
+			"peer_cidr_blocks.0":   "10.0.0.0/16",
+			"allow_cql":            "true",
+			"recreate_when_failed": "false",
+			"vpc_peering_id":       "5",
+			"connection_id":        "pcx-1",
+			"status":               "ACTIVE",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cluster_id":       42,
+		"datacenter":       "AWS_US_EAST_1",
+		"peer_vpc_id":      "vpc-1",
+		"peer_region":      "us-east-1",
+		"peer_account_id":  "123",
+		"peer_cidr_blocks": []interface{}{"10.0.0.0/16"},
+		"allow_cql":        false,
+	})
+
+	diff, err := r.Diff(t.Context(), state, config, nil)
+	require.NoError(t, err)
+	require.True(t, diff.RequiresNew())
+	require.True(t, diff.Attributes["allow_cql"].RequiresNew)
+}
+
+func TestResourceVPCPeeringPeeredClusterOverlap(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/account/7/cluster/42/dcs":
+			_, _ = w.Write([]byte(`{"data":{"dataCenters":[{"id":1,"name":"AWS_US_EAST_1","cidrBlock":"172.31.0.0/16"}]}}`))
+		case "/account/7/clusters":
+			_, _ = w.Write([]byte(`{"data":{"clusters":[{"id":42},{"id":43}]}}`))
+		case "/account/7/cluster/43/network/vpc/peer":
+			_, _ = w.Write([]byte(`{"data":[{"id":9,"externalId":"pcx-9","vpcId":"vpc-1","status":"ACTIVE"}]}`))
+		case "/account/7/cluster/43/dcs":
+			_, _ = w.Write([]byte(`{"data":{"dataCenters":[{"id":3,"name":"AWS_US_EAST_1","cidrBlock":"172.31.128.0/20"}]}}`))
+		default:
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+	})
+
+	r := ResourceVPCPeering()
+	for vpc, wantErr := range map[string]string{
+		"vpc-1": "172.31.0.0/16 (cluster 42 datacenter AWS_US_EAST_1) overlaps 172.31.128.0/20 " +
+			"(cluster 43 datacenter AWS_US_EAST_1, peered with vpc-1 by vpc peering ID=9 (pcx-9))",
+		"vpc-2": "",
+	} {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"cluster_id":       42,
+			"datacenter":       "AWS_US_EAST_1",
+			"peer_vpc_id":      vpc,
+			"peer_region":      "us-east-1",
+			"peer_account_id":  "123",
+			"peer_cidr_blocks": []interface{}{"10.0.0.0/16"},
+		})
+
+		_, err := r.Diff(t.Context(), nil, config, c)
+		if wantErr != "" {
+			require.ErrorContains(t, err, wantErr)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+}
+
+func TestResourceVPCPeeringCreateAlreadyPeered(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/account/7/cluster/42/dcs":
+			_, _ = w.Write([]byte(`{"data":{"dataCenters":[{"id":1,"name":"AWS_US_EAST_1","cloudProviderId":1}]}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/account/7/cluster/42/network/vpc/peer":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"041103"}`))
+		default:
+			t.Errorf("unexpected request %s %q", r.Method, r.URL.Path)
+		}
+	})
+
+	d := ResourceVPCPeering().TestResourceData()
+	for k, v := range map[string]interface{}{
+		"cluster_id":       42,
+		"datacenter":       "AWS_US_EAST_1",
+		"peer_vpc_id":      "vpc-1",
+		"peer_region":      "us-east-1",
+		"peer_account_id":  "123",
+		"peer_cidr_blocks": []interface{}{"10.0.0.0/16"},
+	} {
+		require.NoError(t, d.Set(k, v))
+	}
+
+	diags := resourceVPCPeeringCreate(t.Context(), d, c)
+	require.True(t, diags.HasError())
+	require.ErrorContains(t, errorFromDiags(diags), "the peer VPC is still peered with the cluster")
+	require.Empty(t, d.Id())
+}
+
+func errorFromDiags(diags diag.Diagnostics) error {
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			return errors.New(d.Summary)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package schemautils
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ParseCIDR parses s as an IPv4 or IPv6 CIDR block. A bare address is treated
+// as a single-host network (/32 or /128). Host bits are cleared, so
+// "10.0.0.1/24" and "10.0.0.0/24" yield the same prefix.
+func ParseCIDR(s string) (netip.Prefix, error) {
+	s = strings.TrimSpace(s)
+
+	if !strings.Contains(s, "/") {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid CIDR block %q: %w", s, err)
+		}
+		if addr.Zone() != "" {
+			return netip.Prefix{}, fmt.Errorf("invalid CIDR block %q: zones are not allowed", s)
+		}
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid CIDR block %q: %w", s, err)
+	}
+
+	return p.Masked(), nil
+}
+
+// CanonicalCIDR returns the canonical string form of the CIDR block s.
+func CanonicalCIDR(s string) (string, error) {
+	p, err := ParseCIDR(s)
+	if err != nil {
+		return "", err
+	}
+	return p.String(), nil
+}
+
+// EquivalentCIDRs reports whether lhs and rhs denote the same network.
+// Unparsable values are only equivalent when they are equal strings.
+func EquivalentCIDRs(lhs, rhs string) bool {
+	if lhs == rhs {
+		return true
+	}
+
+	l, err := ParseCIDR(lhs)
+	if err != nil {
+		return false
+	}
+
+	r, err := ParseCIDR(rhs)
+	if err != nil {
+		return false
+	}
+
+	return l == r
+}
+
+// SuppressEquivalentCIDRDiff is a schema.SchemaDiffSuppressFunc that hides
+// differences between equivalent spellings of the same CIDR block.
+func SuppressEquivalentCIDRDiff(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	return EquivalentCIDRs(oldValue, newValue)
+}
+
+// ValidateCIDRDiag is a schema.SchemaValidateDiagFunc accepting IPv4 and IPv6
+// CIDR blocks as well as bare addresses.
+func ValidateCIDRDiag(v interface{}, _ cty.Path) diag.Diagnostics {
+	s, ok := v.(string)
+	if !ok {
+		return diag.Errorf("expected a string, got %T", v)
+	}
+
+	if _, err := ParseCIDR(s); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// CIDRsOverlap reports whether the CIDR blocks lhs and rhs share any address.
+func CIDRsOverlap(lhs, rhs string) (bool, error) {
+	l, err := ParseCIDR(lhs)
+	if err != nil {
+		return false, err
+	}
+
+	r, err := ParseCIDR(rhs)
+	if err != nil {
+		return false, err
+	}
+
+	return l.Overlaps(r), nil
+}
+
+// CIDRSource is a CIDR block together with a human-readable description of
+// where it comes from, e.g. "cluster VPC" or "vpc peering pcx-1234".
+type CIDRSource struct {
+	CIDR   string
+	Source string
+}
+
+// CIDROverlap describes a requested CIDR block that overlaps another one.
+type CIDROverlap struct {
+	CIDR  CIDRSource
+	Other CIDRSource
+}
+
+func (o CIDROverlap) String() string {
+	return fmt.Sprintf("%s (%s) overlaps %s (%s)", o.CIDR.CIDR, o.CIDR.Source, o.Other.CIDR, o.Other.Source)
+}
+
+// FindCIDROverlaps returns every block in cidrs that overlaps another block
+// in cidrs or any block in existing. An invalid requested block is an error,
+// while invalid existing blocks, which come from the API, are skipped.
+func FindCIDROverlaps(cidrs, existing []CIDRSource) ([]CIDROverlap, error) {
+	type parsed struct {
+		CIDRSource
+		prefix netip.Prefix
+	}
+
+	requested := make([]parsed, 0, len(cidrs))
+	for _, c := range cidrs {
+		p, err := ParseCIDR(c.CIDR)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", c.Source, err)
+		}
+		requested = append(requested, parsed{c, p})
+	}
+
+	var overlaps []CIDROverlap
+
+	for i, r := range requested {
+		for _, other := range requested[i+1:] {
+			if r.prefix.Overlaps(other.prefix) {
+				overlaps = append(overlaps, CIDROverlap{r.CIDRSource, other.CIDRSource})
+			}
+		}
+
+		for _, e := range existing {
+			p, err := ParseCIDR(e.CIDR)
+			if err != nil {
+				continue
+			}
+			if r.prefix.Overlaps(p) {
+				overlaps = append(overlaps, CIDROverlap{r.CIDRSource, e})
+			}
+		}
+	}
+
+	return overlaps, nil
+}
+
+// CIDROverlapError returns an error listing all overlaps, or nil if there
+// are none.
+func CIDROverlapError(overlaps []CIDROverlap) error {
+	if len(overlaps) == 0 {
+		return nil
+	}
+
+	var b strings.Builder
+	b.WriteString("overlapping CIDR blocks:")
+	for _, o := range overlaps {
+		b.WriteString("\n  - ")
+		b.WriteString(o.String())
+	}
+
+	return errors.New(b.String())
+}
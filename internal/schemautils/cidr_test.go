@@ -0,0 +1,161 @@
+package schemautils_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/schemautils"
+)
+
+func TestCanonicalCIDR(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cidr    string
+		want    string
+		wantErr bool
+	}{
+		{name: "canonical ipv4", cidr: "10.0.0.0/24", want: "10.0.0.0/24"},
+		{name: "ipv4 host bits set", cidr: "10.0.0.1/24", want: "10.0.0.0/24"},
+		{name: "bare ipv4", cidr: "89.74.148.54", want: "89.74.148.54/32"},
+		{name: "surrounding whitespace", cidr: " 10.0.0.0/8 ", want: "10.0.0.0/8"},
+		{name: "canonical ipv6", cidr: "2001:db8::/32", want: "2001:db8::/32"},
+		{name: "ipv6 host bits set", cidr: "2001:db8::1/64", want: "2001:db8::/64"},
+		{name: "ipv6 uppercase", cidr: "2001:DB8::/32", want: "2001:db8::/32"},
+		{name: "bare ipv6", cidr: "2001:db8::1", want: "2001:db8::1/128"},
+		{name: "ipv4-mapped ipv6", cidr: "::ffff:10.0.0.1", want: "10.0.0.1/32"},
+		{name: "zone", cidr: "fe80::1%eth0", wantErr: true},
+		{name: "prefix too long", cidr: "10.0.0.0/33", wantErr: true},
+		{name: "garbage", cidr: "not-a-cidr", wantErr: true},
+		{name: "empty", cidr: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := schemautils.CanonicalCIDR(tt.cidr)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEquivalentCIDRs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		lhs, rhs string
+		want     bool
+	}{
+		{name: "equal", lhs: "10.0.0.0/24", rhs: "10.0.0.0/24", want: true},
+		{name: "host bits", lhs: "10.0.0.1/24", rhs: "10.0.0.0/24", want: true},
+		{name: "bare address", lhs: "89.74.148.54", rhs: "89.74.148.54/32", want: true},
+		{name: "different prefix length", lhs: "10.0.0.0/24", rhs: "10.0.0.0/16"},
+		{name: "ipv6 spelling", lhs: "2001:0db8::/32", rhs: "2001:db8::/32", want: true},
+		{name: "unparsable equal", lhs: "foo", rhs: "foo", want: true},
+		{name: "unparsable different", lhs: "foo", rhs: "10.0.0.0/8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, schemautils.EquivalentCIDRs(tt.lhs, tt.rhs))
+			require.Equal(t, tt.want, schemautils.SuppressEquivalentCIDRDiff("", tt.lhs, tt.rhs, nil))
+		})
+	}
+}
+
+func TestValidateCIDRDiag(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, schemautils.ValidateCIDRDiag("10.0.0.0/8", cty.Path{}))
+	require.Nil(t, schemautils.ValidateCIDRDiag("2001:db8::/32", cty.Path{}))
+	require.NotNil(t, schemautils.ValidateCIDRDiag("10.0.0.0/40", cty.Path{}))
+	require.NotNil(t, schemautils.ValidateCIDRDiag(42, cty.Path{}))
+}
+
+func TestCIDRsOverlap(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		lhs, rhs string
+		want     bool
+		wantErr  bool
+	}{
+		{name: "same", lhs: "10.0.0.0/24", rhs: "10.0.0.0/24", want: true},
+		{name: "contained", lhs: "10.0.0.0/16", rhs: "10.0.1.0/24", want: true},
+		{name: "bare address inside", lhs: "10.0.1.7", rhs: "10.0.0.0/16", want: true},
+		{name: "adjacent", lhs: "10.0.0.0/24", rhs: "10.0.1.0/24"},
+		{name: "different families", lhs: "10.0.0.0/8", rhs: "2001:db8::/32"},
+		{name: "invalid", lhs: "10.0.0.0/8", rhs: "bogus", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := schemautils.CIDRsOverlap(tt.lhs, tt.rhs)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFindCIDROverlaps(t *testing.T) {
+	t.Parallel()
+
+	vpc := schemautils.CIDRSource{CIDR: "172.31.0.0/24", Source: "cluster VPC"}
+	conn := schemautils.CIDRSource{CIDR: "10.1.0.0/16", Source: "connection"}
+	broken := schemautils.CIDRSource{CIDR: "bogus", Source: "api"}
+
+	a := schemautils.CIDRSource{CIDR: "10.0.0.0/16", Source: "peer_cidr_blocks"}
+	b := schemautils.CIDRSource{CIDR: "10.0.5.0/24", Source: "peer_cidr_blocks"}
+	c := schemautils.CIDRSource{CIDR: "10.1.2.0/24", Source: "peer_cidr_blocks"}
+	d := schemautils.CIDRSource{CIDR: "192.168.0.0/16", Source: "peer_cidr_blocks"}
+
+	overlaps, err := schemautils.FindCIDROverlaps(
+		[]schemautils.CIDRSource{a, b, c, d},
+		[]schemautils.CIDRSource{vpc, conn, broken},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []schemautils.CIDROverlap{
+		{CIDR: a, Other: b},
+		{CIDR: c, Other: conn},
+	}, overlaps)
+
+	overlaps, err = schemautils.FindCIDROverlaps([]schemautils.CIDRSource{d}, []schemautils.CIDRSource{vpc})
+	require.NoError(t, err)
+	require.Empty(t, overlaps)
+
+	_, err = schemautils.FindCIDROverlaps([]schemautils.CIDRSource{broken}, nil)
+	require.Error(t, err)
+}
+
+func TestCIDROverlapError(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, schemautils.CIDROverlapError(nil))
+
+	err := schemautils.CIDROverlapError([]schemautils.CIDROverlap{{
+		CIDR:  schemautils.CIDRSource{CIDR: "10.0.0.0/16", Source: "cidrlist"},
+		Other: schemautils.CIDRSource{CIDR: "10.0.1.0/24", Source: "cluster 7 VPC"},
+	}})
+	require.EqualError(t, err, "overlapping CIDR blocks:\n  - 10.0.0.0/16 (cidrlist) overlaps 10.0.1.0/24 (cluster 7 VPC)")
+}
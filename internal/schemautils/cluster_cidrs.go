@@ -0,0 +1,132 @@
+package schemautils
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
+)
+
+// ClusterCIDRSources lists the CIDR blocks routed through the cluster
+// network: its datacenter networks, its VPC peerings that did not fail and
+// its cluster connections that were not deleted. The peering with the
+// connection ID skipPeering and the connection with the ID skipConnection
+// are left out, so that a resource does not conflict with itself.
+//
+// A cluster that does not exist has no sources; the apply reports it.
+func ClusterCIDRSources(ctx context.Context, c *scylla.Client, clusterID int64, skipPeering string, skipConnection int64) ([]CIDRSource, error) {
+	dcs, err := c.ListDataCenters(ctx, clusterID)
+	if err != nil {
+		if scylla.IsNotFound(err) || scylla.IsDeletedErr(err) || scylla.IsClusterDeletedErr(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading datacenters of cluster ID=%d: %w", clusterID, err)
+	}
+
+	var sources []CIDRSource
+
+	for _, dc := range dcs {
+		if dc.CIDRBlock == "" {
+			continue
+		}
+		sources = append(sources, CIDRSource{
+			CIDR:   dc.CIDRBlock,
+			Source: fmt.Sprintf("cluster %d datacenter %s", clusterID, dc.Name),
+		})
+	}
+
+	peerings, err := c.ListClusterVPCPeerings(ctx, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("error reading vpc peerings of cluster ID=%d: %w", clusterID, err)
+	}
+
+	for _, vp := range peerings {
+		if vp.Failed() || (skipPeering != "" && strings.EqualFold(vp.ExternalID, skipPeering)) {
+			continue
+		}
+		for _, cidr := range vp.CIDRList {
+			sources = append(sources, CIDRSource{
+				CIDR:   cidr,
+				Source: fmt.Sprintf("vpc peering ID=%d (%s)", vp.ID, vp.ExternalID),
+			})
+		}
+	}
+
+	conns, err := c.ListClusterConnections(ctx, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("error reading cluster connections of cluster ID=%d: %w", clusterID, err)
+	}
+
+	for _, conn := range conns {
+		if conn.ID == skipConnection || strings.EqualFold(conn.Status, "DELETED") {
+			continue
+		}
+		for _, cidr := range conn.CIDRList {
+			sources = append(sources, CIDRSource{
+				CIDR:   cidr,
+				Source: fmt.Sprintf("cluster connection ID=%d (%s)", conn.ID, conn.Name),
+			})
+		}
+	}
+
+	return sources, nil
+}
+
+// PeeredClusterCIDRSources lists the datacenter networks of the clusters of
+// the account, other than skipClusterID, with a VPC peering to peerVPC that
+// did not fail. The peer VPC routes to all of them, so they must not overlap
+// the network of a cluster peering with it too. The API does not report the
+// datacenter of a peering, so all datacenters of such a cluster are listed.
+func PeeredClusterCIDRSources(ctx context.Context, c *scylla.Client, peerVPC string, skipClusterID int64) ([]CIDRSource, error) {
+	clusters, err := c.ListClusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error reading cluster list: %w", err)
+	}
+
+	var sources []CIDRSource
+
+	for _, cluster := range clusters {
+		if cluster.ID == skipClusterID || strings.EqualFold(cluster.Status, "DELETED") {
+			continue
+		}
+
+		peerings, err := c.ListClusterVPCPeerings(ctx, cluster.ID)
+		if err != nil {
+			if scylla.IsNotFound(err) || scylla.IsDeletedErr(err) || scylla.IsClusterDeletedErr(err) {
+				continue
+			}
+			return nil, fmt.Errorf("error reading vpc peerings of cluster ID=%d: %w", cluster.ID, err)
+		}
+
+		i := slices.IndexFunc(peerings, func(vp model.VPCPeering) bool {
+			return !vp.Failed() && strings.EqualFold(vp.VPCID, peerVPC)
+		})
+		if i == -1 {
+			continue
+		}
+
+		dcs, err := c.ListDataCenters(ctx, cluster.ID)
+		if err != nil {
+			if scylla.IsNotFound(err) || scylla.IsDeletedErr(err) || scylla.IsClusterDeletedErr(err) {
+				continue
+			}
+			return nil, fmt.Errorf("error reading datacenters of cluster ID=%d: %w", cluster.ID, err)
+		}
+
+		vp := &peerings[i]
+		for _, dc := range dcs {
+			if dc.CIDRBlock == "" {
+				continue
+			}
+			sources = append(sources, CIDRSource{
+				CIDR:   dc.CIDRBlock,
+				Source: fmt.Sprintf("cluster %d datacenter %s, peered with %s by vpc peering ID=%d (%s)", cluster.ID, dc.Name, peerVPC, vp.ID, vp.ExternalID),
+			})
+		}
+	}
+
+	return sources, nil
+}
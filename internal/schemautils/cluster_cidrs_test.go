@@ -0,0 +1,103 @@
+package schemautils_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/schemautils"
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/scyllatest"
+)
+
+func TestClusterCIDRSources(t *testing.T) {
+	t.Parallel()
+
+	c := scyllatest.NewClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/account/7/cluster/42/dcs":
+			_, _ = w.Write([]byte(`{"data":{"dataCenters":[
+				{"id":1,"name":"AWS_US_EAST_1","cidrBlock":"172.31.0.0/16"},
+				{"id":2,"name":"AWS_EU_WEST_1","cidrBlock":"172.32.0.0/16"},
+				{"id":3,"name":"AWS_EU_NORTH_1"}
+			]}}`))
+		case "/account/7/cluster/42/network/vpc/peer":
+			_, _ = w.Write([]byte(`{"data":[
+				{"id":5,"externalId":"pcx-1","cidrList":["10.0.0.0/16"],"status":"ACTIVE"},
+				{"id":6,"externalId":"pcx-2","cidrList":["10.1.0.0/16"],"status":"PENDING_ACCEPTANCE"},
+				{"id":7,"externalId":"pcx-3","cidrList":["10.2.0.0/16"],"status":"rejected"}
+			]}`))
+		case "/account/7/cluster/42/network/vpc/connection":
+			_, _ = w.Write([]byte(`{"data":{"connections":[
+				{"id":30,"name":"tgw","cidrList":["10.5.0.0/16","10.6.0.0/16"],"status":"ACTIVE"},
+				{"id":31,"name":"other","cidrList":["10.7.0.0/16"],"status":"ACTIVE"},
+				{"id":32,"name":"gone","cidrList":["10.8.0.0/16"],"status":"DELETED"}
+			]}}`))
+		case "/account/7/cluster/43/dcs":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"040001"}`))
+		default:
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+	})
+
+	sources, err := schemautils.ClusterCIDRSources(t.Context(), c, 42, "PCX-2", 31)
+	require.NoError(t, err)
+	require.Equal(t, []schemautils.CIDRSource{
+		{CIDR: "172.31.0.0/16", Source: "cluster 42 datacenter AWS_US_EAST_1"},
+		{CIDR: "172.32.0.0/16", Source: "cluster 42 datacenter AWS_EU_WEST_1"},
+		{CIDR: "10.0.0.0/16", Source: "vpc peering ID=5 (pcx-1)"},
+		{CIDR: "10.5.0.0/16", Source: "cluster connection ID=30 (tgw)"},
+		{CIDR: "10.6.0.0/16", Source: "cluster connection ID=30 (tgw)"},
+	}, sources)
+
+	sources, err = schemautils.ClusterCIDRSources(t.Context(), c, 42, "", 0)
+	require.NoError(t, err)
+	require.Len(t, sources, 7)
+
+	sources, err = schemautils.ClusterCIDRSources(t.Context(), c, 43, "", 0)
+	require.NoError(t, err)
+	require.Empty(t, sources)
+}
+
+func TestPeeredClusterCIDRSources(t *testing.T) {
+	t.Parallel()
+
+	c := scyllatest.NewClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/account/7/clusters":
+			_, _ = w.Write([]byte(`{"data":{"clusters":[
+				{"id":42,"status":"ACTIVE"},
+				{"id":43,"status":"ACTIVE"},
+				{"id":44,"status":"ACTIVE"},
+				{"id":45,"status":"ACTIVE"},
+				{"id":46,"status":"DELETED"}
+			]}}`))
+		case "/account/7/cluster/43/network/vpc/peer":
+			_, _ = w.Write([]byte(`{"data":[
+				{"id":1,"externalId":"pcx-1","vpcId":"vpc-2","status":"ACTIVE"},
+				{"id":2,"externalId":"pcx-2","vpcId":"VPC-1","status":"PENDING_ACCEPTANCE"}
+			]}`))
+		case "/account/7/cluster/43/dcs":
+			_, _ = w.Write([]byte(`{"data":{"dataCenters":[
+				{"id":1,"name":"AWS_US_EAST_1","cidrBlock":"172.31.0.0/16"},
+				{"id":2,"name":"AWS_EU_WEST_1","cidrBlock":"172.32.0.0/16"}
+			]}}`))
+		case "/account/7/cluster/44/network/vpc/peer":
+			// A failed peering does not route anything.
+			_, _ = w.Write([]byte(`{"data":[{"id":3,"externalId":"pcx-3","vpcId":"vpc-1","status":"FAILED"}]}`))
+		case "/account/7/cluster/45/network/vpc/peer":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"040001"}`))
+		default:
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+	})
+
+	sources, err := schemautils.PeeredClusterCIDRSources(t.Context(), c, "vpc-1", 42)
+	require.NoError(t, err)
+	require.Equal(t, []schemautils.CIDRSource{
+		{CIDR: "172.31.0.0/16", Source: "cluster 43 datacenter AWS_US_EAST_1, peered with vpc-1 by vpc peering ID=2 (pcx-2)"},
+		{CIDR: "172.32.0.0/16", Source: "cluster 43 datacenter AWS_EU_WEST_1, peered with vpc-1 by vpc peering ID=2 (pcx-2)"},
+	}, sources)
+}
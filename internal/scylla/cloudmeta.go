@@ -88,9 +88,9 @@ type Cloudmeta struct {
 func BuildCloudmeta(ctx context.Context, c *Client) (*Cloudmeta, error) {
 	var meta Cloudmeta
 
-	b, err := parse(blocks, blocksDelim, blocksFunc)
+	b, err := DefaultGCPBlocks()
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse cidr blocks: %w", err)
+		return nil, err
 	}
 
 	meta.GCPBlocks = b
@@ -124,6 +124,33 @@ func BuildCloudmeta(ctx context.Context, c *Client) (*Cloudmeta, error) {
 	return &meta, nil
 }
 
+// DefaultGCPBlocks returns the default GCP peer CIDR blocks built into the
+// provider, keyed by region.
+func DefaultGCPBlocks() (map[string]string, error) {
+	b, err := parse(blocks, blocksDelim, blocksFunc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cidr blocks: %w", err)
+	}
+	return b, nil
+}
+
+// GCPBlock returns the default peer CIDR block for the given GCP region.
+func (m *Cloudmeta) GCPBlock(region string) (string, bool) {
+	cidr, ok := m.GCPBlocks[region]
+	return cidr, ok
+}
+
+// AddGCPBlocks overrides or extends the default GCP peer CIDR blocks,
+// which are otherwise limited to the table built into the provider.
+func (m *Cloudmeta) AddGCPBlocks(blocks map[string]string) {
+	if m.GCPBlocks == nil {
+		m.GCPBlocks = make(map[string]string, len(blocks))
+	}
+	for region, cidr := range blocks {
+		m.GCPBlocks[region] = cidr
+	}
+}
+
 func (m *Cloudmeta) ProviderByName(name string) *CloudProvider {
 	for i := range m.CloudProviders {
 		p := &m.CloudProviders[i]
@@ -0,0 +1,37 @@
+package scylla
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudmetaAddGCPBlocks(t *testing.T) {
+	t.Parallel()
+
+	b, err := DefaultGCPBlocks()
+	require.NoError(t, err)
+
+	m := &Cloudmeta{GCPBlocks: b}
+	m.AddGCPBlocks(map[string]string{
+		"us-central1":  "10.10.0.0/20",
+		"me-central99": "10.250.0.0/20",
+	})
+
+	cidr, ok := m.GCPBlock("us-central1")
+	require.True(t, ok)
+	require.Equal(t, "10.10.0.0/20", cidr, "configured block must override the built-in one")
+
+	cidr, ok = m.GCPBlock("me-central99")
+	require.True(t, ok)
+	require.Equal(t, "10.250.0.0/20", cidr, "configured block must extend the built-in table")
+
+	cidr, ok = m.GCPBlock("europe-west1")
+	require.True(t, ok)
+	require.Equal(t, "10.132.0.0/20", cidr)
+
+	empty := &Cloudmeta{}
+	empty.AddGCPBlocks(map[string]string{"us-central1": "10.10.0.0/20"})
+	_, ok = empty.GCPBlock("us-central1")
+	require.True(t, ok)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
 )
@@ -255,22 +256,33 @@ func (c *Client) ListCloudAccounts(ctx context.Context) ([]model.CloudAccount, e
 	return result, nil
 }
 
-func (c *Client) ListAvailabilityZoneIDs(ctx context.Context, cloudAccountID int64, regionID int64) ([]string, error) {
-	var result []struct {
-		ID   string `json:"id"`
-		Name string `json:"name"`
-	}
+// ListAvailabilityZones returns the availability zones of the region that are
+// available to the cloud account, ordered by zone ID.
+func (c *Client) ListAvailabilityZones(ctx context.Context, cloudAccountID int64, regionID int64) ([]model.AvailabilityZone, error) {
+	var result []model.AvailabilityZone
 
 	path := fmt.Sprintf("/account/%d/cloud-account/%d/region/%d/zones", c.AccountID, cloudAccountID, regionID)
 	if err := c.get(ctx, path, &result); err != nil {
 		return nil, err
 	}
 
-	azIDs := make([]string, 0, len(result))
-	for _, item := range result {
-		azIDs = append(azIDs, item.ID)
+	slices.SortFunc(result, func(lhs, rhs model.AvailabilityZone) int {
+		return strings.Compare(lhs.ID, rhs.ID)
+	})
+
+	return result, nil
+}
+
+func (c *Client) ListAvailabilityZoneIDs(ctx context.Context, cloudAccountID int64, regionID int64) ([]string, error) {
+	zones, err := c.ListAvailabilityZones(ctx, cloudAccountID, regionID)
+	if err != nil {
+		return nil, err
+	}
+
+	azIDs := make([]string, 0, len(zones))
+	for _, z := range zones {
+		azIDs = append(azIDs, z.ID)
 	}
-	slices.Sort(azIDs)
 
 	return azIDs, nil
 }
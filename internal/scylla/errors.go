@@ -47,6 +47,15 @@ func IsEncryptionAtRestUnavailableErr(err error) bool {
 	return false
 }
 
+// IsAlreadyPeeredErr reports whether err is API error 041103:
+// "Provided VPC is already peered".
+func IsAlreadyPeeredErr(err error) bool {
+	if e := new(APIError); errors.As(err, &e) && e.Code == "041103" {
+		return true
+	}
+	return false
+}
+
 func IsNotFound(err error) bool {
 	if e := new(APIError); errors.As(err, &e) && e.StatusCode == http.StatusNotFound {
 		return true
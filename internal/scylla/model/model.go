@@ -31,6 +31,13 @@ func FindScyllaCloudAccount(accounts []CloudAccount, cloudProviderID int64) *Clo
 	return nil
 }
 
+// AvailabilityZone is an availability zone of a cloud region, identified by
+// its account-independent ID (e.g. use1-az1) and its name (e.g. us-east-1a).
+type AvailabilityZone struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
 type CloudProviders struct {
 	CloudProviders []CloudProvider `json:"cloudProviders"`
 }
@@ -359,6 +366,40 @@ type VPCPeering struct {
 	AllowCQL         bool     `json:"allowCql"`
 }
 
+// Statuses of a VPC peering. They follow the life cycle of an AWS peering
+// connection; a GCP peering is INACTIVE until the peer network peers back.
+const (
+	VPCPeeringInitiatingRequest = "INITIATING_REQUEST"
+	VPCPeeringPendingAcceptance = "PENDING_ACCEPTANCE"
+	VPCPeeringProvisioning      = "PROVISIONING"
+	VPCPeeringActive            = "ACTIVE"
+	VPCPeeringInactive          = "INACTIVE"
+	VPCPeeringFailed            = "FAILED"
+	VPCPeeringRejected          = "REJECTED"
+	VPCPeeringExpired           = "EXPIRED"
+	VPCPeeringDeleting          = "DELETING"
+	VPCPeeringDeleted           = "DELETED"
+)
+
+// VPCPeeringFailedStatuses are the statuses a peering never leaves; such a
+// peering carries no traffic.
+var VPCPeeringFailedStatuses = []string{
+	VPCPeeringFailed,
+	VPCPeeringRejected,
+	VPCPeeringExpired,
+	VPCPeeringDeleted,
+}
+
+// Failed reports whether the peering reached a status it never leaves.
+func (vp *VPCPeering) Failed() bool {
+	for _, s := range VPCPeeringFailedStatuses {
+		if strings.EqualFold(vp.Status, s) {
+			return true
+		}
+	}
+	return false
+}
+
 func (vp *VPCPeering) NetworkLink() string {
 	return "projects/" + vp.ProjectID + "/global/networks/" + vp.NetworkName
 }
@@ -410,6 +451,49 @@ type DatacenterConnection struct {
 	DNS       []string `json:"dns"`
 }
 
+// CQLConnectionConfig kind and API version of connection bundles.
+const (
+	CQLConnectionConfigKind       = "CQLConnectionConfig"
+	CQLConnectionConfigAPIVersion = "cqlclient.scylla.scylladb.com/v1alpha1"
+)
+
+// CQLConnectionConfig is the connection bundle format read by the Scylla
+// drivers, see github.com/gocql/gocql/scyllacloud.
+type CQLConnectionConfig struct {
+	Kind           string                              `json:"kind"`
+	APIVersion     string                              `json:"apiVersion"`
+	Datacenters    map[string]*CQLConnectionDatacenter `json:"datacenters"`
+	AuthInfos      map[string]*CQLConnectionAuthInfo   `json:"authInfos"`
+	Contexts       map[string]*CQLConnectionContext    `json:"contexts"`
+	CurrentContext string                              `json:"currentContext"`
+	Parameters     *CQLConnectionParameters            `json:"parameters,omitempty"`
+}
+
+type CQLConnectionDatacenter struct {
+	CertificateAuthorityData []byte `json:"certificateAuthorityData,omitempty"`
+	Server                   string `json:"server"`
+	TLSServerName            string `json:"tlsServerName,omitempty"`
+	NodeDomain               string `json:"nodeDomain,omitempty"`
+	InsecureSkipTLSVerify    bool   `json:"insecureSkipTlsVerify,omitempty"`
+}
+
+type CQLConnectionAuthInfo struct {
+	ClientCertificateData []byte `json:"clientCertificateData,omitempty"`
+	ClientKeyData         []byte `json:"clientKeyData,omitempty"`
+	Username              string `json:"username,omitempty"`
+	Password              string `json:"password,omitempty"`
+}
+
+type CQLConnectionContext struct {
+	DatacenterName string `json:"datacenterName"`
+	AuthInfoName   string `json:"authInfoName"`
+}
+
+type CQLConnectionParameters struct {
+	DefaultConsistency       string `json:"defaultConsistency,omitempty"`
+	DefaultSerialConsistency string `json:"defaultSerialConsistency,omitempty"`
+}
+
 type ClusterDetails struct {
 	Cluster Cluster `json:"cluster"`
 }
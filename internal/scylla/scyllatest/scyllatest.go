@@ -0,0 +1,40 @@
+// Package scyllatest provides helpers for testing code that calls the
+// ScyllaDB Cloud API.
+package scyllatest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/eapache/go-resiliency/retrier"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+)
+
+// AccountID is the account ID of the clients returned by NewClient.
+const AccountID = 7
+
+// NewClient starts a test server serving h and returns a client of account
+// AccountID talking to it, without retries. The server is closed when the
+// test ends.
+func NewClient(t testing.TB, h http.HandlerFunc) *scylla.Client {
+	t.Helper()
+
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	endpoint, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &scylla.Client{
+		Endpoint:   endpoint,
+		Headers:    make(http.Header),
+		HTTPClient: srv.Client(),
+		Retry:      retrier.New(nil, nil),
+		AccountID:  AccountID,
+	}
+}